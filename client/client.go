@@ -4,15 +4,10 @@ package client
 
 import (
 	"fmt"
-	"regexp"
 
 	"github.com/Coderlane/go-minecraft-rcon/rcon"
 )
 
-var (
-	cmdRegex *regexp.Regexp = regexp.MustCompile(`^[ \w\-\.\;\:\,]+$`)
-)
-
 type Client interface {
 	Request(cmd string) (string, error)
 	Send(cmd string) error
@@ -35,8 +30,10 @@ func NewClient(address, password string) (Client, error) {
 	}, nil
 }
 
+// validateCommand rejects commands that could smuggle a second command or
+// otherwise confuse the server. Arguments should be escaped with Command.
 func validateCommand(cmd string) error {
-	if !cmdRegex.MatchString(cmd) {
+	if len(cmd) == 0 || validateText(cmd) != nil {
 		return fmt.Errorf("invalid command: %s", cmd)
 	}
 	return nil
@@ -50,6 +50,21 @@ func TestRequestInvalid(t *testing.T) {
 	expectError(t, err, "invalid command: req")
 }
 
+func TestRequestSelector(t *testing.T) {
+	resp, err := testClient.Request(`req @a[distance=..10,nbt={a:"b"}] ~ ^1 /`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp != "resp" {
+		t.Error("Expected \"resp\":", resp)
+	}
+}
+
+func TestRequestEmpty(t *testing.T) {
+	_, err := testClient.Request("")
+	expectError(t, err, "invalid command")
+}
+
 func TestSend(t *testing.T) {
 	err := testClient.Send("snd")
 	if err != nil {
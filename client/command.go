@@ -0,0 +1,148 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	literalRegex  *regexp.Regexp = regexp.MustCompile(`^[\w\-\.\+:/#]+$`)
+	unquotedRegex *regexp.Regexp = regexp.MustCompile(`^[\w\-\.\+]+$`)
+)
+
+// Argument is implemented by values that know how to render themselves as
+// part of a command, such as selectors or coordinates.
+type Argument interface {
+	CommandArgument() (string, error)
+}
+
+// Command builds a command line from individually escaped arguments. The
+// first invalid argument is remembered and returned by Build.
+type Command struct {
+	args []string
+	err  error
+}
+
+// NewCommand starts a new command with the given name.
+func NewCommand(name string) *Command {
+	return new(Command).Literal(name)
+}
+
+// validateText checks that text only contains characters the server accepts
+// in a command, which rules out newlines and other control characters.
+func validateText(text string) error {
+	if !utf8.ValidString(text) {
+		return fmt.Errorf("invalid utf-8 in: %q", text)
+	}
+	for _, r := range text {
+		if r < ' ' || r == '\u007f' || r == '§' {
+			return fmt.Errorf("invalid character %q in: %q", r, text)
+		}
+	}
+	return nil
+}
+
+// Quote wraps str in double quotes and escapes any quotes or backslashes in it.
+func Quote(str string) string {
+	str = strings.ReplaceAll(str, `\`, `\\`)
+	str = strings.ReplaceAll(str, `"`, `\"`)
+	return `"` + str + `"`
+}
+
+func (cmd *Command) append(arg string, err error) *Command {
+	if cmd.err != nil {
+		return cmd
+	}
+	if err != nil {
+		cmd.err = err
+		return cmd
+	}
+	cmd.args = append(cmd.args, arg)
+	return cmd
+}
+
+// Literal appends a keyword such as a subcommand name or a resource location.
+func (cmd *Command) Literal(lit string) *Command {
+	if !literalRegex.MatchString(lit) {
+		return cmd.append("", fmt.Errorf("invalid literal: %q", lit))
+	}
+	return cmd.append(lit, nil)
+}
+
+// Word appends a string argument, quoting it only if it needs to be.
+func (cmd *Command) Word(str string) *Command {
+	if unquotedRegex.MatchString(str) {
+		return cmd.append(str, nil)
+	}
+	return cmd.Quoted(str)
+}
+
+// Quoted appends a string argument that is always quoted.
+func (cmd *Command) Quoted(str string) *Command {
+	return cmd.append(Quote(str), validateText(str))
+}
+
+// Text appends free-form text, such as the message for say. Text consumes the
+// rest of the line, so it must be the last argument.
+func (cmd *Command) Text(text string) *Command {
+	return cmd.append(text, validateText(text))
+}
+
+// Int appends an integer argument.
+func (cmd *Command) Int(i int) *Command {
+	return cmd.append(strconv.Itoa(i), nil)
+}
+
+// Float appends a floating point argument.
+func (cmd *Command) Float(f float64) *Command {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return cmd.append("", fmt.Errorf("invalid number: %v", f))
+	}
+	return cmd.append(strconv.FormatFloat(f, 'f', -1, 64), nil)
+}
+
+// Bool appends true or false.
+func (cmd *Command) Bool(b bool) *Command {
+	return cmd.append(strconv.FormatBool(b), nil)
+}
+
+// JSON appends v encoded as JSON, as used by text components.
+func (cmd *Command) JSON(v interface{}) *Command {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return cmd.append("", err)
+	}
+	return cmd.append(string(data), validateText(string(data)))
+}
+
+// Arg appends a value that renders itself.
+func (cmd *Command) Arg(arg Argument) *Command {
+	if arg == nil {
+		return cmd.append("", fmt.Errorf("missing argument"))
+	}
+	str, err := arg.CommandArgument()
+	if err != nil {
+		return cmd.append("", err)
+	}
+	return cmd.append(str, validateText(str))
+}
+
+// Build returns the full command line, or the first error encountered while
+// building it.
+func (cmd *Command) Build() (string, error) {
+	if cmd.err != nil {
+		return "", cmd.err
+	}
+	return strings.Join(cmd.args, " "), nil
+}
+
+// CommandArgument allows a command to be nested in another, as with
+// execute ... run.
+func (cmd *Command) CommandArgument() (string, error) {
+	return cmd.Build()
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"math"
+	"testing"
+)
+
+type testArgument string
+
+func (arg testArgument) CommandArgument() (string, error) {
+	return string(arg), nil
+}
+
+func TestCommandBuild(t *testing.T) {
+	type testCase struct {
+		cmd      *Command
+		expected string
+	}
+	testCases := []testCase{
+		{NewCommand("list"), "list"},
+		{NewCommand("ban").Word("test_user"), "ban test_user"},
+		{NewCommand("team").Literal("add").Word("red team"),
+			`team add "red team"`},
+		{NewCommand("tag").Quoted(`say "hi" \o/`),
+			`tag "say \"hi\" \\o/"`},
+		{NewCommand("say").Text("Hello @a [1/2]!"), "say Hello @a [1/2]!"},
+		{NewCommand("give").Literal("minecraft:stone").Int(-3).Float(1.5).
+			Bool(true), "give minecraft:stone -3 1.5 true"},
+		{NewCommand("tellraw").Arg(testArgument("@a[distance=..10]")).
+			JSON(map[string]string{"text": "hi"}),
+			`tellraw @a[distance=..10] {"text":"hi"}`},
+		{NewCommand("execute").Literal("run").Arg(NewCommand("say").Text("x")),
+			"execute run say x"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			cmd, err := tcase.cmd.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cmd != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, cmd)
+			}
+		})
+	}
+}
+
+func TestCommandBuildFailures(t *testing.T) {
+	type testCase struct {
+		name string
+		cmd  *Command
+		err  string
+	}
+	testCases := []testCase{
+		{"Newline", NewCommand("say").Text("hi\nop attacker"),
+			"invalid character"},
+		{"QuotedNewline", NewCommand("tag").Quoted("a\nb"), "invalid character"},
+		{"Section", NewCommand("say").Text("§4red"), "invalid character"},
+		{"Literal", NewCommand("list ; op"), "invalid literal"},
+		{"LiteralSpace", NewCommand("team").Literal("add x"), "invalid literal"},
+		{"NaN", NewCommand("tp").Float(math.NaN()), "invalid number"},
+		{"Argument", NewCommand("kill").Arg(testArgument("@e\n")),
+			"invalid character"},
+		{"NilArgument", NewCommand("kill").Arg(nil), "missing argument"},
+		{"FirstError", NewCommand("a b").Text("\n"), "invalid literal"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := tcase.cmd.Build()
+			expectError(t, err, tcase.err)
+		})
+	}
+}
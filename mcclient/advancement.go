@@ -0,0 +1,84 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	advancementCountRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:Granted|Revoked) (\d+) (?:advancements|criteria)`)
+)
+
+// AdvancementMode selects which advancements are granted or revoked,
+// relative to the named advancement.
+type AdvancementMode string
+
+const (
+	// AdvancementOnly affects just the advancement, or one of its criteria.
+	AdvancementOnly AdvancementMode = "only"
+	// AdvancementFrom affects the advancement and its children.
+	AdvancementFrom AdvancementMode = "from"
+	// AdvancementUntil affects the advancement and its parents.
+	AdvancementUntil AdvancementMode = "until"
+	// AdvancementThrough affects the advancement, its parents and children.
+	AdvancementThrough AdvancementMode = "through"
+	// AdvancementEverything affects every advancement.
+	AdvancementEverything AdvancementMode = "everything"
+)
+
+// advancement runs grant or revoke. Advancement must be empty for
+// AdvancementEverything and criterion may only be set for AdvancementOnly.
+func (mc *MinecraftClient) advancement(action, prefix string, target Target,
+	mode AdvancementMode, advancement, criterion string) (int, error) {
+	cmd := client.NewCommand("advancement").Literal(action).Arg(target).
+		Literal(string(mode))
+	switch mode {
+	case AdvancementEverything:
+		if advancement != "" {
+			return 0, fmt.Errorf("unexpected advancement for %s: %s", mode,
+				advancement)
+		}
+	case AdvancementOnly, AdvancementFrom, AdvancementUntil,
+		AdvancementThrough:
+		cmd.Literal(advancement)
+	default:
+		return 0, fmt.Errorf("invalid advancement mode: %s", mode)
+	}
+	if criterion != "" {
+		if mode != AdvancementOnly {
+			return 0, fmt.Errorf("criterion requires %s", AdvancementOnly)
+		}
+		cmd.Text(criterion)
+	}
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasPrefix(resp, prefix) {
+		return 0, newResponseError(resp)
+	}
+	if advancementCountRegex.MatchString(resp) {
+		return parseResponseInt(resp, advancementCountRegex)
+	}
+	return 1, nil
+}
+
+// AdvancementGrant grants advancements, or a single criterion of one, to the
+// targeted players and returns how many were granted to each player.
+func (mc *MinecraftClient) AdvancementGrant(target Target,
+	mode AdvancementMode, advancement, criterion string) (int, error) {
+	return mc.advancement("grant", "Granted", target, mode, advancement,
+		criterion)
+}
+
+// AdvancementRevoke revokes advancements, or a single criterion of one, from
+// the targeted players and returns how many were revoked from each player.
+func (mc *MinecraftClient) AdvancementRevoke(target Target,
+	mode AdvancementMode, advancement, criterion string) (int, error) {
+	return mc.advancement("revoke", "Revoked", target, mode, advancement,
+		criterion)
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAdvancementGrantSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	type testCase struct {
+		name        string
+		mode        AdvancementMode
+		advancement string
+		criterion   string
+		command     string
+		response    string
+		count       int
+	}
+	testCases := []testCase{
+		{"Only", AdvancementOnly, "minecraft:story/mine_diamond", "",
+			"advancement grant test only minecraft:story/mine_diamond",
+			"Granted the advancement [Diamonds!] to test", 1},
+		{"Criterion", AdvancementOnly, "minecraft:adventure/adventuring_time",
+			"minecraft:plains",
+			"advancement grant test only minecraft:adventure/adventuring_time " +
+				"minecraft:plains",
+			"Granted criterion 'minecraft:plains' of advancement " +
+				"[Adventuring Time] to test", 1},
+		{"Through", AdvancementThrough, "minecraft:story/mine_diamond", "",
+			"advancement grant test through minecraft:story/mine_diamond",
+			"Granted 5 advancements to test", 5},
+		{"Everything", AdvancementEverything, "", "",
+			"advancement grant test everything",
+			"Granted 122 advancements to test", 122},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			tc.client.EXPECT().Request(tcase.command).Return(tcase.response, nil)
+			count, err := tc.mc.AdvancementGrant(Player("test"), tcase.mode,
+				tcase.advancement, tcase.criterion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tcase.count {
+				t.Errorf("Expected: %d Got: %d", tcase.count, count)
+			}
+		})
+	}
+}
+
+func TestAdvancementRevokeSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		"advancement revoke @a from minecraft:story/root").
+		Return("Revoked 16 advancements from 2 players", nil)
+	count, err := tc.mc.AdvancementRevoke(NewSelector(SelectorAllPlayers),
+		AdvancementFrom, "minecraft:story/root", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 16 {
+		t.Errorf("Expected: 16 Got: %d", count)
+	}
+}
+
+func TestAdvancementFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	_, err := tc.mc.AdvancementGrant(Player("test"), AdvancementEverything,
+		"minecraft:story/root", "")
+	expectError(t, err, "unexpected advancement for everything")
+	_, err = tc.mc.AdvancementGrant(Player("test"), AdvancementFrom,
+		"minecraft:story/root", "crafted")
+	expectError(t, err, "criterion requires only")
+	_, err = tc.mc.AdvancementGrant(Player("test"), AdvancementMode("all"),
+		"minecraft:story/root", "")
+	expectError(t, err, "invalid advancement mode: all")
+
+	tc.client.EXPECT().Request(
+		"advancement grant test only minecraft:story/root").
+		Return("Couldn't grant advancement [Minecraft] to test as they "+
+			"already have it", nil)
+	_, err = tc.mc.AdvancementGrant(Player("test"), AdvancementOnly,
+		"minecraft:story/root", "")
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+}
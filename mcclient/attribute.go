@@ -0,0 +1,95 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	attributeValueRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:Base value|Value) of attribute .+ for entity .+ is ` +
+			`(-?\d+(?:\.\d+)?(?:E-?\d+)?)$`)
+)
+
+// ModifierOperation is how an attribute modifier changes the value of an
+// attribute.
+type ModifierOperation string
+
+const (
+	// ModifierAddValue adds the amount to the base value.
+	ModifierAddValue ModifierOperation = "add_value"
+	// ModifierAddMultipliedBase adds the base value multiplied by the amount.
+	ModifierAddMultipliedBase ModifierOperation = "add_multiplied_base"
+	// ModifierAddMultipliedTotal multiplies the total by one plus the amount.
+	ModifierAddMultipliedTotal ModifierOperation = "add_multiplied_total"
+)
+
+// CommandArgument validates and returns the operation.
+func (op ModifierOperation) CommandArgument() (string, error) {
+	switch op {
+	case ModifierAddValue, ModifierAddMultipliedBase,
+		ModifierAddMultipliedTotal:
+		return string(op), nil
+	}
+	return "", fmt.Errorf("invalid modifier operation: %s", string(op))
+}
+
+func newAttributeCommand(target Target, attribute string) *client.Command {
+	return client.NewCommand("attribute").Arg(target).Literal(attribute)
+}
+
+func (mc *MinecraftClient) attributeValue(cmd *client.Command) (float64,
+	error) {
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return 0, err
+	}
+	matches, err := parseResponse(resp, attributeValueRegex)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(matches[1], 64)
+}
+
+// AttributeGet returns the value of attribute, such as
+// minecraft:max_health, for the targeted entity including all modifiers.
+func (mc *MinecraftClient) AttributeGet(target Target,
+	attribute string) (float64, error) {
+	return mc.attributeValue(newAttributeCommand(target, attribute).
+		Literal("get"))
+}
+
+// AttributeBaseGet returns the base value of attribute for the targeted
+// entity.
+func (mc *MinecraftClient) AttributeBaseGet(target Target,
+	attribute string) (float64, error) {
+	return mc.attributeValue(newAttributeCommand(target, attribute).
+		Literal("base").Literal("get"))
+}
+
+// AttributeBaseSet sets the base value of attribute for the targeted entity.
+func (mc *MinecraftClient) AttributeBaseSet(target Target, attribute string,
+	value float64) error {
+	return mc.simpleRequest(newAttributeCommand(target, attribute).
+		Literal("base").Literal("set").Float(value), "Base value for attribute")
+}
+
+// AttributeModifierAdd adds a modifier named id, such as
+// example:speed_boost, to attribute for the targeted entity.
+func (mc *MinecraftClient) AttributeModifierAdd(target Target, attribute,
+	id string, amount float64, op ModifierOperation) error {
+	return mc.simpleRequest(newAttributeCommand(target, attribute).
+		Literal("modifier").Literal("add").Literal(id).Float(amount).
+		Arg(op), "Added modifier")
+}
+
+// AttributeModifierRemove removes the modifier named id from attribute for
+// the targeted entity.
+func (mc *MinecraftClient) AttributeModifierRemove(target Target, attribute,
+	id string) error {
+	return mc.simpleRequest(newAttributeCommand(target, attribute).
+		Literal("modifier").Literal("remove").Literal(id), "Removed modifier")
+}
@@ -0,0 +1,91 @@
+package client
+
+import "testing"
+
+func TestAttributeGetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("attribute test minecraft:max_health get").
+		Return("Value of attribute Max Health for entity test is 24.0", nil)
+	value, err := tc.mc.AttributeGet(Player("test"), "minecraft:max_health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 24 {
+		t.Errorf("Expected: 24 Got: %v", value)
+	}
+	tc.client.EXPECT().Request(
+		"attribute test minecraft:movement_speed base get").
+		Return("Base value of attribute Speed for entity test is 0.1", nil)
+	value, err = tc.mc.AttributeBaseGet(Player("test"),
+		"minecraft:movement_speed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 0.1 {
+		t.Errorf("Expected: 0.1 Got: %v", value)
+	}
+	tc.client.EXPECT().Request(
+		"attribute test minecraft:movement_speed base get").
+		Return("Base value of attribute Speed for entity test is 1.0E-4", nil)
+	value, err = tc.mc.AttributeBaseGet(Player("test"),
+		"minecraft:movement_speed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 1e-4 {
+		t.Errorf("Expected: 1.0E-4 Got: %v", value)
+	}
+}
+
+func TestAttributeGetFailure(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("attribute test minecraft:armor get").
+		Return("Entity test has no attribute Armor", nil)
+	_, err := tc.mc.AttributeGet(Player("test"), "minecraft:armor")
+	expectError(t, err, "has no attribute")
+}
+
+func TestAttributeSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		"attribute test minecraft:max_health base set 40").
+		Return("Base value for attribute Max Health for entity test set to 40.0",
+			nil)
+	err := tc.mc.AttributeBaseSet(Player("test"), "minecraft:max_health", 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("attribute test minecraft:movement_speed "+
+		"modifier add example:boost 0.5 add_multiplied_base").
+		Return("Added modifier example:boost to attribute Speed for entity test",
+			nil)
+	err = tc.mc.AttributeModifierAdd(Player("test"), "minecraft:movement_speed",
+		"example:boost", 0.5, ModifierAddMultipliedBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("attribute test minecraft:movement_speed "+
+		"modifier remove example:boost").
+		Return("Removed modifier example:boost from attribute Speed for "+
+			"entity test", nil)
+	err = tc.mc.AttributeModifierRemove(Player("test"),
+		"minecraft:movement_speed", "example:boost")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttributeModifierAddInvalidOperation(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.AttributeModifierAdd(Player("test"), "minecraft:armor",
+		"example:bonus", 2, ModifierOperation("multiply_base"))
+	expectError(t, err, "invalid modifier operation: multiply_base")
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+var (
+	blockPropertyRegex *regexp.Regexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+	blockCountRegex    *regexp.Regexp = regexp.MustCompile(
+		`^Successfully (?:filled|cloned) (\d+) block`)
+)
+
+// BlockState describes a block, such as minecraft:oak_stairs, along with its
+// block state properties and block entity data. As a filter, ID may also be a
+// block tag such as #minecraft:logs.
+type BlockState struct {
+	ID         string
+	Properties map[string]string
+	NBT        nbt.Compound
+}
+
+// CommandArgument formats the block as id[properties]{nbt}.
+func (block BlockState) CommandArgument() (string, error) {
+	if err := validateResource(block.ID); err != nil {
+		return "", err
+	}
+	out := block.ID
+	if len(block.Properties) > 0 {
+		keys := make([]string, 0, len(block.Properties))
+		for key := range block.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		parts := make([]string, len(keys))
+		for i, key := range keys {
+			value := block.Properties[key]
+			if !blockPropertyRegex.MatchString(key) ||
+				!blockPropertyRegex.MatchString(value) {
+				return "", fmt.Errorf("invalid block property: %s=%s", key, value)
+			}
+			parts[i] = key + "=" + value
+		}
+		out += "[" + strings.Join(parts, ",") + "]"
+	}
+	if len(block.NBT) > 0 {
+		out += block.NBT.String()
+	}
+	return out, nil
+}
+
+// SetBlockMode is how setblock treats the block already in place.
+type SetBlockMode string
+
+const (
+	// SetBlockReplace replaces the existing block.
+	SetBlockReplace SetBlockMode = "replace"
+	// SetBlockDestroy breaks the existing block, dropping it as an item.
+	SetBlockDestroy SetBlockMode = "destroy"
+	// SetBlockKeep only places the block in air.
+	SetBlockKeep SetBlockMode = "keep"
+)
+
+// FillMode is how fill treats the blocks already in the region.
+type FillMode string
+
+const (
+	// FillReplace replaces every block, or only those matching a filter.
+	FillReplace FillMode = "replace"
+	// FillDestroy breaks the existing blocks, dropping them as items.
+	FillDestroy FillMode = "destroy"
+	// FillHollow fills the outer layer and replaces the inside with air.
+	FillHollow FillMode = "hollow"
+	// FillOutline fills the outer layer and leaves the inside alone.
+	FillOutline FillMode = "outline"
+	// FillKeep only fills air.
+	FillKeep FillMode = "keep"
+)
+
+// CloneMask selects which source blocks are cloned.
+type CloneMask string
+
+const (
+	// CloneReplace clones every block.
+	CloneReplace CloneMask = "replace"
+	// CloneMasked clones every block except air.
+	CloneMasked CloneMask = "masked"
+	// CloneFiltered clones only blocks matching a filter.
+	CloneFiltered CloneMask = "filtered"
+)
+
+// CloneMode is how clone treats the source and destination.
+type CloneMode string
+
+const (
+	// CloneNormal copies the blocks, failing if the regions overlap.
+	CloneNormal CloneMode = "normal"
+	// CloneForce copies the blocks even if the regions overlap.
+	CloneForce CloneMode = "force"
+	// CloneMove copies the blocks and replaces the source with air.
+	CloneMove CloneMode = "move"
+)
+
+func (mc *MinecraftClient) blockCount(cmd *client.Command) (int, error) {
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, blockCountRegex)
+}
+
+// SetBlock places block at pos.
+func (mc *MinecraftClient) SetBlock(pos Vec3, block BlockState,
+	mode SetBlockMode) error {
+	switch mode {
+	case SetBlockReplace, SetBlockDestroy, SetBlockKeep:
+	default:
+		return fmt.Errorf("invalid setblock mode: %s", mode)
+	}
+	return mc.simpleRequest(client.NewCommand("setblock").Arg(blockPos(pos)).
+		Arg(block).Literal(string(mode)), "Changed the block")
+}
+
+// Fill fills the region from one corner to the other with block and returns
+// the number of blocks changed. Filter limits FillReplace to matching blocks
+// and must be nil for every other mode.
+func (mc *MinecraftClient) Fill(from, to Vec3, block BlockState,
+	mode FillMode, filter *BlockState) (int, error) {
+	switch mode {
+	case FillReplace, FillDestroy, FillHollow, FillOutline, FillKeep:
+	default:
+		return 0, fmt.Errorf("invalid fill mode: %s", mode)
+	}
+	cmd := client.NewCommand("fill").Arg(blockPos(from)).Arg(blockPos(to)).
+		Arg(block).Literal(string(mode))
+	if filter != nil {
+		if mode != FillReplace {
+			return 0, fmt.Errorf("filter requires %s", FillReplace)
+		}
+		cmd.Arg(filter)
+	}
+	return mc.blockCount(cmd)
+}
+
+// Clone copies the region from begin to end so that its lowest corner is at
+// dest and returns the number of blocks cloned. Filter is required for
+// CloneFiltered and must be nil otherwise.
+func (mc *MinecraftClient) Clone(begin, end, dest Vec3, mask CloneMask,
+	filter *BlockState, mode CloneMode) (int, error) {
+	switch mode {
+	case CloneNormal, CloneForce, CloneMove:
+	default:
+		return 0, fmt.Errorf("invalid clone mode: %s", mode)
+	}
+	cmd := client.NewCommand("clone").Arg(blockPos(begin)).Arg(blockPos(end)).
+		Arg(blockPos(dest))
+	switch mask {
+	case CloneReplace, CloneMasked:
+		if filter != nil {
+			return 0, fmt.Errorf("filter requires %s", CloneFiltered)
+		}
+		cmd.Literal(string(mask))
+	case CloneFiltered:
+		if filter == nil {
+			return 0, fmt.Errorf("%s requires a filter", CloneFiltered)
+		}
+		cmd.Literal(string(mask)).Arg(filter)
+	default:
+		return 0, fmt.Errorf("invalid clone mask: %s", mask)
+	}
+	return mc.blockCount(cmd.Literal(string(mode)))
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+func TestBlockStateRender(t *testing.T) {
+	type testCase struct {
+		block    BlockState
+		expected string
+	}
+	testCases := []testCase{
+		{BlockState{ID: "minecraft:stone"}, "minecraft:stone"},
+		{BlockState{ID: "#minecraft:logs"}, "#minecraft:logs"},
+		{BlockState{ID: "minecraft:oak_stairs", Properties: map[string]string{
+			"half": "top", "facing": "east",
+		}}, "minecraft:oak_stairs[facing=east,half=top]"},
+		{BlockState{ID: "minecraft:chest", Properties: map[string]string{
+			"facing": "north",
+		}, NBT: nbt.Compound{"Lock": nbt.String("key")}},
+			`minecraft:chest[facing=north]{Lock:"key"}`},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			str, err := tcase.block.CommandArgument()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+		})
+	}
+}
+
+func TestBlockStateRenderFailures(t *testing.T) {
+	_, err := BlockState{ID: "Stone"}.CommandArgument()
+	expectError(t, err, "invalid resource location: Stone")
+	_, err = BlockState{ID: "minecraft:oak_stairs", Properties: map[string]string{
+		"facing": "east]",
+	}}.CommandArgument()
+	expectError(t, err, "invalid block property: facing=east]")
+}
+
+func TestSetBlockSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("setblock 1 64 -3 minecraft:stone keep").
+		Return("Changed the block at 1, 64, -3", nil)
+	err := tc.mc.SetBlock(Pos(1, 64, -3), BlockState{ID: "minecraft:stone"},
+		SetBlockKeep)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetBlockFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.SetBlock(Pos(1.5, 64, -3), BlockState{ID: "minecraft:stone"},
+		SetBlockKeep)
+	expectError(t, err, "invalid block position: 1.5")
+	err = tc.mc.SetBlock(Here(), BlockState{ID: "minecraft:stone"},
+		SetBlockMode("place"))
+	expectError(t, err, "invalid setblock mode: place")
+
+	tc.client.EXPECT().Request("setblock ~ ~ ~ minecraft:stone replace").
+		Return("Could not set the block", nil)
+	err = tc.mc.SetBlock(Here(), BlockState{ID: "minecraft:stone"},
+		SetBlockReplace)
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+}
+
+func TestFillSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		"fill 0 60 0 2 62 2 minecraft:glass replace #minecraft:logs").
+		Return("Successfully filled 27 block(s)", nil)
+	count, err := tc.mc.Fill(Pos(0, 60, 0), Pos(2, 62, 2),
+		BlockState{ID: "minecraft:glass"}, FillReplace,
+		&BlockState{ID: "#minecraft:logs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 27 {
+		t.Errorf("Expected: 27 Got: %d", count)
+	}
+	tc.client.EXPECT().Request("fill 0 60 0 4 64 4 minecraft:stone hollow").
+		Return("Successfully filled 125 block(s)", nil)
+	count, err = tc.mc.Fill(Pos(0, 60, 0), Pos(4, 64, 4),
+		BlockState{ID: "minecraft:stone"}, FillHollow, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 125 {
+		t.Errorf("Expected: 125 Got: %d", count)
+	}
+}
+
+func TestFillFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	_, err := tc.mc.Fill(Pos(0, 0, 0), Pos(1, 1, 1),
+		BlockState{ID: "minecraft:stone"}, FillKeep,
+		&BlockState{ID: "minecraft:air"})
+	expectError(t, err, "filter requires replace")
+	_, err = tc.mc.Fill(Pos(0, 0, 0), Pos(1, 1, 1),
+		BlockState{ID: "minecraft:stone"}, FillMode("solid"), nil)
+	expectError(t, err, "invalid fill mode: solid")
+
+	tc.client.EXPECT().Request("fill 0 0 0 100 100 100 minecraft:stone keep").
+		Return("Too many blocks in the specified area (maximum 32768, "+
+			"specified 1030301)", nil)
+	_, err = tc.mc.Fill(Pos(0, 0, 0), Pos(100, 100, 100),
+		BlockState{ID: "minecraft:stone"}, FillKeep, nil)
+	if !errors.Is(err, ErrTooManyBlocks) {
+		t.Errorf("Expected: %v Got: %v", ErrTooManyBlocks, err)
+	}
+	expectError(t, err, "Too many blocks in the specified area")
+}
+
+func TestCloneSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("clone 0 60 0 9 69 9 100 60 0 masked move").
+		Return("Successfully cloned 420 block(s)", nil)
+	count, err := tc.mc.Clone(Pos(0, 60, 0), Pos(9, 69, 9), Pos(100, 60, 0),
+		CloneMasked, nil, CloneMove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 420 {
+		t.Errorf("Expected: 420 Got: %d", count)
+	}
+	tc.client.EXPECT().Request(
+		"clone 0 60 0 9 69 9 5 60 0 filtered minecraft:gold_block force").
+		Return("Successfully cloned 3 block(s)", nil)
+	count, err = tc.mc.Clone(Pos(0, 60, 0), Pos(9, 69, 9), Pos(5, 60, 0),
+		CloneFiltered, &BlockState{ID: "minecraft:gold_block"}, CloneForce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("Expected: 3 Got: %d", count)
+	}
+}
+
+func TestCloneFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	_, err := tc.mc.Clone(Pos(0, 0, 0), Pos(1, 1, 1), Pos(5, 0, 0),
+		CloneFiltered, nil, CloneNormal)
+	expectError(t, err, "filtered requires a filter")
+	_, err = tc.mc.Clone(Pos(0, 0, 0), Pos(1, 1, 1), Pos(5, 0, 0),
+		CloneMasked, &BlockState{ID: "minecraft:stone"}, CloneNormal)
+	expectError(t, err, "filter requires filtered")
+	_, err = tc.mc.Clone(Pos(0, 0, 0), Pos(1, 1, 1), Pos(5, 0, 0),
+		CloneMasked, nil, CloneMode("copy"))
+	expectError(t, err, "invalid clone mode: copy")
+
+	tc.client.EXPECT().Request("clone 0 0 0 1 1 1 5 0 0 replace normal").
+		Return("No blocks were cloned", nil)
+	_, err = tc.mc.Clone(Pos(0, 0, 0), Pos(1, 1, 1), Pos(5, 0, 0),
+		CloneReplace, nil, CloneNormal)
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+}
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	bossbarValueRegex *regexp.Regexp = regexp.MustCompile(
+		`^Custom bossbar \[.*\] has a value of (-?\d+)$`)
+	bossbarMaxRegex *regexp.Regexp = regexp.MustCompile(
+		`^Custom bossbar \[.*\] has a maximum of (-?\d+)$`)
+	bossbarVisibleRegex *regexp.Regexp = regexp.MustCompile(
+		`^Custom bossbar \[.*\] is currently (shown|hidden)$`)
+	bossbarPlayersRegex *regexp.Regexp = regexp.MustCompile(
+		`^Custom bossbar \[.*\] has (?:no players|\d+ players?(?:\(s\))?) ` +
+			`currently online(?:: (.*))?$`)
+)
+
+// BossbarColor is the color of a bossbar.
+type BossbarColor string
+
+// The bossbar colors.
+const (
+	BossbarBlue   BossbarColor = "blue"
+	BossbarGreen  BossbarColor = "green"
+	BossbarPink   BossbarColor = "pink"
+	BossbarPurple BossbarColor = "purple"
+	BossbarRed    BossbarColor = "red"
+	BossbarWhite  BossbarColor = "white"
+	BossbarYellow BossbarColor = "yellow"
+)
+
+func (color BossbarColor) validate() error {
+	switch color {
+	case BossbarBlue, BossbarGreen, BossbarPink, BossbarPurple, BossbarRed,
+		BossbarWhite, BossbarYellow:
+		return nil
+	}
+	return fmt.Errorf("invalid bossbar color: %s", color)
+}
+
+// BossbarStyle is how a bossbar is divided.
+type BossbarStyle string
+
+const (
+	// BossbarProgress is a single continuous bar.
+	BossbarProgress BossbarStyle = "progress"
+	// BossbarNotched6 divides the bar into 6 segments.
+	BossbarNotched6 BossbarStyle = "notched_6"
+	// BossbarNotched10 divides the bar into 10 segments.
+	BossbarNotched10 BossbarStyle = "notched_10"
+	// BossbarNotched12 divides the bar into 12 segments.
+	BossbarNotched12 BossbarStyle = "notched_12"
+	// BossbarNotched20 divides the bar into 20 segments.
+	BossbarNotched20 BossbarStyle = "notched_20"
+)
+
+func (style BossbarStyle) validate() error {
+	switch style {
+	case BossbarProgress, BossbarNotched6, BossbarNotched10,
+		BossbarNotched12, BossbarNotched20:
+		return nil
+	}
+	return fmt.Errorf("invalid bossbar style: %s", style)
+}
+
+// Bossbar is the state of a custom bossbar.
+type Bossbar struct {
+	Value   int
+	Max     int
+	Visible bool
+	// Players are the online players that can see the bossbar.
+	Players []string
+}
+
+func newBossbarCommand(subcommand, id string) *client.Command {
+	cmd := client.NewCommand("bossbar").Literal(subcommand)
+	if len(id) > 0 {
+		cmd.Literal(id)
+	}
+	return cmd
+}
+
+// BossbarAdd creates a bossbar with a resource location id, such as
+// event:timer.
+func (mc *MinecraftClient) BossbarAdd(id string, name TextComponent) error {
+	return mc.simpleRequest(newBossbarCommand("add", id).Arg(name),
+		"Created custom bossbar")
+}
+
+// BossbarRemove removes a bossbar.
+func (mc *MinecraftClient) BossbarRemove(id string) error {
+	return mc.simpleRequest(newBossbarCommand("remove", id),
+		"Removed custom bossbar")
+}
+
+// BossbarList returns the display names of every bossbar. Display names are
+// not the IDs that BossbarGet, BossbarRemove and the setters take.
+func (mc *MinecraftClient) BossbarList() (displayNames []string, err error) {
+	resp, err := mc.request(newBossbarCommand("list", ""))
+	if err != nil {
+		return nil, err
+	}
+	return parseBracketedList(resp, "There are",
+		"There are no custom bossbars active")
+}
+
+func (mc *MinecraftClient) bossbarGet(id, property string) (string, error) {
+	return mc.request(newBossbarCommand("get", id).Literal(property))
+}
+
+// BossbarGet returns the state of a bossbar. This takes one request for each
+// property.
+func (mc *MinecraftClient) BossbarGet(id string) (Bossbar, error) {
+	var bossbar Bossbar
+	resp, err := mc.bossbarGet(id, "value")
+	if err != nil {
+		return bossbar, err
+	}
+	if bossbar.Value, err = parseResponseInt(resp, bossbarValueRegex); err != nil {
+		return bossbar, err
+	}
+	if resp, err = mc.bossbarGet(id, "max"); err != nil {
+		return bossbar, err
+	}
+	if bossbar.Max, err = parseResponseInt(resp, bossbarMaxRegex); err != nil {
+		return bossbar, err
+	}
+	if resp, err = mc.bossbarGet(id, "visible"); err != nil {
+		return bossbar, err
+	}
+	visible, err := parseResponse(resp, bossbarVisibleRegex)
+	if err != nil {
+		return bossbar, err
+	}
+	bossbar.Visible = visible[1] == "shown"
+	if resp, err = mc.bossbarGet(id, "players"); err != nil {
+		return bossbar, err
+	}
+	players, err := parseResponse(resp, bossbarPlayersRegex)
+	if err != nil {
+		return bossbar, err
+	}
+	bossbar.Players = []string{}
+	if len(players[1]) > 0 {
+		for _, player := range strings.Split(players[1], ",") {
+			bossbar.Players = append(bossbar.Players, strings.TrimSpace(player))
+		}
+	}
+	return bossbar, nil
+}
+
+func (mc *MinecraftClient) bossbarSet(id, property string,
+	cmd func(*client.Command)) error {
+	setCmd := newBossbarCommand("set", id).Literal(property)
+	cmd(setCmd)
+	return mc.simpleRequest(setCmd, "Custom bossbar")
+}
+
+// BossbarSetName sets the text shown above a bossbar.
+func (mc *MinecraftClient) BossbarSetName(id string, name TextComponent) error {
+	return mc.bossbarSet(id, "name", func(cmd *client.Command) {
+		cmd.Arg(name)
+	})
+}
+
+// BossbarSetColor sets the color of a bossbar.
+func (mc *MinecraftClient) BossbarSetColor(id string,
+	color BossbarColor) error {
+	if err := color.validate(); err != nil {
+		return err
+	}
+	return mc.bossbarSet(id, "color", func(cmd *client.Command) {
+		cmd.Literal(string(color))
+	})
+}
+
+// BossbarSetStyle sets how a bossbar is divided.
+func (mc *MinecraftClient) BossbarSetStyle(id string,
+	style BossbarStyle) error {
+	if err := style.validate(); err != nil {
+		return err
+	}
+	return mc.bossbarSet(id, "style", func(cmd *client.Command) {
+		cmd.Literal(string(style))
+	})
+}
+
+// BossbarSetValue sets how full a bossbar is. Each update is one request, so a
+// timer updating every second fits within the default rate limit as long as
+// nothing else is sent.
+func (mc *MinecraftClient) BossbarSetValue(id string, value int) error {
+	if value < 0 {
+		return fmt.Errorf("invalid value: %d", value)
+	}
+	return mc.bossbarSet(id, "value", func(cmd *client.Command) {
+		cmd.Int(value)
+	})
+}
+
+// BossbarSetMax sets the value at which a bossbar is full.
+func (mc *MinecraftClient) BossbarSetMax(id string, max int) error {
+	if max < 1 {
+		return fmt.Errorf("invalid max: %d", max)
+	}
+	return mc.bossbarSet(id, "max", func(cmd *client.Command) {
+		cmd.Int(max)
+	})
+}
+
+// BossbarSetVisible shows or hides a bossbar.
+func (mc *MinecraftClient) BossbarSetVisible(id string, visible bool) error {
+	return mc.bossbarSet(id, "visible", func(cmd *client.Command) {
+		cmd.Bool(visible)
+	})
+}
+
+// BossbarSetPlayers sets which players can see a bossbar. A nil target hides
+// it from everyone.
+func (mc *MinecraftClient) BossbarSetPlayers(id string, players Target) error {
+	return mc.bossbarSet(id, "players", func(cmd *client.Command) {
+		if players != nil {
+			cmd.Arg(players)
+		}
+	})
+}
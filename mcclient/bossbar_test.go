@@ -0,0 +1,188 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBossbarAddRemoveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`bossbar add event:timer {"text":"Time Left"}`).
+		Return("Created custom bossbar [Time Left]", nil)
+	if err := tc.mc.BossbarAdd("event:timer", Text("Time Left")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`bossbar add event:timer {"text":"Time Left"}`).
+		Return("A bossbar already exists with the ID 'event:timer'", nil)
+	err := tc.mc.BossbarAdd("event:timer", Text("Time Left"))
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists: %v", err)
+	}
+	tc.client.EXPECT().Request("bossbar remove event:timer").
+		Return("Removed custom bossbar [Time Left]", nil)
+	if err := tc.mc.BossbarRemove("event:timer"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBossbarListSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("bossbar list").
+		Return("There are 2 custom bossbar(s) active: [Time Left], [Boss]", nil)
+	bossbars, err := tc.mc.BossbarList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Time Left", "Boss"}
+	if !reflect.DeepEqual(bossbars, expected) {
+		t.Errorf("Got: %v Expected: %v", bossbars, expected)
+	}
+	tc.client.EXPECT().Request("bossbar list").
+		Return("There are no custom bossbars active", nil)
+	bossbars, err = tc.mc.BossbarList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bossbars) != 0 {
+		t.Errorf("Expected no bossbars: %v", bossbars)
+	}
+}
+
+func TestBossbarGetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("bossbar get event:timer value").
+		Return("Custom bossbar [Time Left] has a value of 30", nil)
+	tc.client.EXPECT().Request("bossbar get event:timer max").
+		Return("Custom bossbar [Time Left] has a maximum of 60", nil)
+	tc.client.EXPECT().Request("bossbar get event:timer visible").
+		Return("Custom bossbar [Time Left] is currently shown", nil)
+	tc.client.EXPECT().Request("bossbar get event:timer players").
+		Return("Custom bossbar [Time Left] has 2 player(s) currently online: "+
+			"test, other", nil)
+	bossbar, err := tc.mc.BossbarGet("event:timer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Bossbar{
+		Value:   30,
+		Max:     60,
+		Visible: true,
+		Players: []string{"test", "other"},
+	}
+	if !reflect.DeepEqual(bossbar, expected) {
+		t.Errorf("\nExpected: %+v\nGot:      %+v", expected, bossbar)
+	}
+}
+
+func TestBossbarGetNoPlayers(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("bossbar get event:timer value").
+		Return("Custom bossbar [Time Left] has a value of 0", nil)
+	tc.client.EXPECT().Request("bossbar get event:timer max").
+		Return("Custom bossbar [Time Left] has a maximum of 100", nil)
+	tc.client.EXPECT().Request("bossbar get event:timer visible").
+		Return("Custom bossbar [Time Left] is currently hidden", nil)
+	tc.client.EXPECT().Request("bossbar get event:timer players").
+		Return("Custom bossbar [Time Left] has no players currently online", nil)
+	bossbar, err := tc.mc.BossbarGet("event:timer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Bossbar{
+		Max:     100,
+		Players: []string{},
+	}
+	if !reflect.DeepEqual(bossbar, expected) {
+		t.Errorf("\nExpected: %+v\nGot:      %+v", expected, bossbar)
+	}
+}
+
+func TestBossbarGetUnknown(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("bossbar get event:timer value").
+		Return("No bossbar exists with the ID 'event:timer'", nil)
+	_, err := tc.mc.BossbarGet("event:timer")
+	if !errors.Is(err, ErrUnknownBossbar) {
+		t.Errorf("Expected ErrUnknownBossbar: %v", err)
+	}
+}
+
+func TestBossbarSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`bossbar set event:timer name {"text":"Hurry"}`).
+		Return("Custom bossbar [Hurry] has been renamed", nil)
+	if err := tc.mc.BossbarSetName("event:timer", Text("Hurry")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer color red").
+		Return("Custom bossbar [Hurry] has changed color", nil)
+	if err := tc.mc.BossbarSetColor("event:timer", BossbarRed); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer style notched_10").
+		Return("Custom bossbar [Hurry] has changed style", nil)
+	err := tc.mc.BossbarSetStyle("event:timer", BossbarNotched10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer value 29").
+		Return("Custom bossbar [Hurry] has changed value to 29", nil)
+	if err := tc.mc.BossbarSetValue("event:timer", 29); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer max 60").
+		Return("Custom bossbar [Hurry] has changed maximum to 60", nil)
+	if err := tc.mc.BossbarSetMax("event:timer", 60); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer visible true").
+		Return("Custom bossbar [Hurry] is now visible", nil)
+	if err := tc.mc.BossbarSetVisible("event:timer", true); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer players @a").
+		Return("Custom bossbar [Hurry] now has 2 player(s): test, other", nil)
+	err = tc.mc.BossbarSetPlayers("event:timer",
+		NewSelector(SelectorAllPlayers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("bossbar set event:timer players").
+		Return("Custom bossbar [Hurry] no longer has any players", nil)
+	if err := tc.mc.BossbarSetPlayers("event:timer", nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBossbarSetErrors(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("bossbar set event:timer value 5").
+		Return("Nothing changed. That's already the value of this bossbar", nil)
+	err := tc.mc.BossbarSetValue("event:timer", 5)
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected ErrNothingChanged: %v", err)
+	}
+	err = tc.mc.BossbarSetColor("event:timer", "orange")
+	expectError(t, err, "invalid bossbar color: orange")
+	err = tc.mc.BossbarSetStyle("event:timer", "notched_3")
+	expectError(t, err, "invalid bossbar style: notched_3")
+	err = tc.mc.BossbarSetValue("event:timer", -1)
+	expectError(t, err, "invalid value: -1")
+	err = tc.mc.BossbarSetMax("event:timer", 0)
+	expectError(t, err, "invalid max: 0")
+}
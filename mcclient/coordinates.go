@@ -0,0 +1,132 @@
+package client
+
+import (
+	"fmt"
+	"math"
+)
+
+// CoordinateKind is how a coordinate is interpreted.
+type CoordinateKind int
+
+const (
+	// Absolute coordinates are world coordinates.
+	Absolute CoordinateKind = iota
+	// Relative coordinates, written ~, are offsets from the current position.
+	Relative
+	// Local coordinates, written ^, are offsets relative to the direction the
+	// entity is facing.
+	Local
+)
+
+// Coordinate is a single component of a position or rotation.
+type Coordinate struct {
+	Value float64
+	Kind  CoordinateKind
+}
+
+// Abs creates an absolute coordinate.
+func Abs(value float64) Coordinate {
+	return Coordinate{Value: value, Kind: Absolute}
+}
+
+// Rel creates a relative coordinate.
+func Rel(offset float64) Coordinate {
+	return Coordinate{Value: offset, Kind: Relative}
+}
+
+// Loc creates a local coordinate.
+func Loc(offset float64) Coordinate {
+	return Coordinate{Value: offset, Kind: Local}
+}
+
+// String formats the coordinate, leaving out zero offsets as in ~ or ^.
+func (coord Coordinate) String() string {
+	prefix := ""
+	switch coord.Kind {
+	case Relative:
+		prefix = "~"
+	case Local:
+		prefix = "^"
+	}
+	if len(prefix) > 0 && coord.Value == 0 {
+		return prefix
+	}
+	return prefix + formatNumber(coord.Value)
+}
+
+func (coord Coordinate) validate() error {
+	if math.IsNaN(coord.Value) || math.IsInf(coord.Value, 0) {
+		return fmt.Errorf("invalid coordinate: %v", coord.Value)
+	}
+	if coord.Kind < Absolute || coord.Kind > Local {
+		return fmt.Errorf("invalid coordinate kind: %d", coord.Kind)
+	}
+	return nil
+}
+
+// Vec3 is a position made of three coordinates.
+type Vec3 struct {
+	X, Y, Z Coordinate
+}
+
+// Pos creates an absolute position.
+func Pos(x, y, z float64) Vec3 {
+	return Vec3{X: Abs(x), Y: Abs(y), Z: Abs(z)}
+}
+
+// Here is the current position, ~ ~ ~.
+func Here() Vec3 {
+	return Vec3{X: Rel(0), Y: Rel(0), Z: Rel(0)}
+}
+
+// CommandArgument validates and formats the position. Local coordinates can
+// not be mixed with other kinds.
+func (vec Vec3) CommandArgument() (string, error) {
+	local := 0
+	for _, coord := range []Coordinate{vec.X, vec.Y, vec.Z} {
+		if err := coord.validate(); err != nil {
+			return "", err
+		}
+		if coord.Kind == Local {
+			local++
+		}
+	}
+	if local != 0 && local != 3 {
+		return "", fmt.Errorf("can not mix local coordinates: %s %s %s",
+			vec.X, vec.Y, vec.Z)
+	}
+	return vec.X.String() + " " + vec.Y.String() + " " + vec.Z.String(), nil
+}
+
+// blockPos is a position that must land on a whole block.
+type blockPos Vec3
+
+// CommandArgument validates that absolute coordinates are whole numbers and
+// formats the position.
+func (pos blockPos) CommandArgument() (string, error) {
+	for _, coord := range []Coordinate{pos.X, pos.Y, pos.Z} {
+		if coord.Kind == Absolute && coord.Value != math.Trunc(coord.Value) {
+			return "", fmt.Errorf("invalid block position: %v", coord.Value)
+		}
+	}
+	return Vec3(pos).CommandArgument()
+}
+
+// Rotation is a direction made of a yaw and a pitch, in degrees.
+type Rotation struct {
+	Yaw, Pitch Coordinate
+}
+
+// CommandArgument validates and formats the rotation. Rotations can not use
+// local coordinates.
+func (rot Rotation) CommandArgument() (string, error) {
+	for _, coord := range []Coordinate{rot.Yaw, rot.Pitch} {
+		if err := coord.validate(); err != nil {
+			return "", err
+		}
+		if coord.Kind == Local {
+			return "", fmt.Errorf("rotations can not be local: %s", coord)
+		}
+	}
+	return rot.Yaw.String() + " " + rot.Pitch.String(), nil
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+func TestCoordinatesRender(t *testing.T) {
+	type testCase struct {
+		arg      client.Argument
+		expected string
+	}
+	testCases := []testCase{
+		{Pos(1, -64, 2.5), "1 -64 2.5"},
+		{Here(), "~ ~ ~"},
+		{Vec3{Rel(0), Rel(10), Abs(5)}, "~ ~10 5"},
+		{Vec3{Loc(0), Loc(0), Loc(-1.5)}, "^ ^ ^-1.5"},
+		{Rotation{Abs(90), Rel(-10)}, "90 ~-10"},
+		{blockPos(Vec3{Abs(1), Rel(0.5), Abs(-3)}), "1 ~0.5 -3"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			str, err := tcase.arg.CommandArgument()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+		})
+	}
+}
+
+func TestCoordinatesRenderFailures(t *testing.T) {
+	type testCase struct {
+		name string
+		arg  client.Argument
+		err  string
+	}
+	testCases := []testCase{
+		{"Mixed", Vec3{Loc(0), Rel(0), Loc(0)}, "can not mix local"},
+		{"NaN", Pos(math.NaN(), 0, 0), "invalid coordinate"},
+		{"Kind", Vec3{Coordinate{0, 7}, Abs(0), Abs(0)},
+			"invalid coordinate kind"},
+		{"LocalRotation", Rotation{Loc(0), Abs(0)}, "can not be local"},
+		{"Block", blockPos(Pos(1.5, 0, 0)), "invalid block position: 1.5"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := tcase.arg.CommandArgument()
+			expectError(t, err, tcase.err)
+		})
+	}
+}
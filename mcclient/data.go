@@ -0,0 +1,100 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+// rawArgument is an argument that has already been validated, such as a
+// formatted NBT path.
+type rawArgument string
+
+// CommandArgument returns the argument unchanged.
+func (arg rawArgument) CommandArgument() (string, error) {
+	return string(arg), nil
+}
+
+// dataGet runs a data get command, appending path if it is set, and parses
+// the SNBT following marker in the response. If the name the server prints
+// before marker is known, it must be name. Otherwise the name may itself
+// contain marker, so the first marker followed by valid SNBT is used.
+func (mc *MinecraftClient) dataGet(cmd *client.Command, path, name,
+	marker string) (nbt.Tag, error) {
+	if len(path) > 0 {
+		parsed, err := nbt.ParsePath(path)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Arg(rawArgument(parsed.String()))
+	}
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(name) > 0 {
+		if !strings.HasPrefix(resp, name+marker) {
+			return nil, newResponseError(resp)
+		}
+		return nbt.Parse(resp[len(name)+len(marker):])
+	}
+	var parseErr error
+	for offset := 0; ; {
+		idx := strings.Index(resp[offset:], marker)
+		if idx < 0 {
+			break
+		}
+		offset += idx + len(marker)
+		tag, err := nbt.Parse(resp[offset:])
+		if err == nil {
+			return tag, nil
+		}
+		if parseErr == nil {
+			parseErr = err
+		}
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	return nil, newResponseError(resp)
+}
+
+// DataGetEntity returns the NBT data of a single entity, or only the part of
+// it selected by path if path is set.
+func (mc *MinecraftClient) DataGetEntity(target Target,
+	path string) (nbt.Tag, error) {
+	// The server prints the display name of the entity, which for players
+	// includes any team prefix and suffix, so it can not be predicted.
+	return mc.dataGet(client.NewCommand("data").Literal("get").
+		Literal("entity").Arg(target), path, "",
+		" has the following entity data: ")
+}
+
+// DataGetBlock returns the NBT data of the block entity at x, y, z, or only
+// the part of it selected by path if path is set.
+func (mc *MinecraftClient) DataGetBlock(x, y, z int,
+	path string) (nbt.Tag, error) {
+	return mc.dataGet(client.NewCommand("data").Literal("get").
+		Literal("block").Int(x).Int(y).Int(z), path,
+		fmt.Sprintf("%d, %d, %d", x, y, z), " has the following block data: ")
+}
+
+// namespaced adds the default minecraft namespace to a resource location
+// that has none, as the server does when it prints one.
+func namespaced(id string) string {
+	if strings.Contains(id, ":") {
+		return id
+	}
+	return "minecraft:" + id
+}
+
+// DataGetStorage returns the contents of the command storage id, or only the
+// part of it selected by path if path is set.
+func (mc *MinecraftClient) DataGetStorage(id string,
+	path string) (nbt.Tag, error) {
+	return mc.dataGet(client.NewCommand("data").Literal("get").
+		Literal("storage").Literal(id), path, "Storage "+namespaced(id),
+		" has the following contents: ")
+}
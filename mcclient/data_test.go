@@ -0,0 +1,157 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+func TestDataGetEntitySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test").
+		Return("test has the following entity data: "+
+			"{Pos: [1.0d, 64.0d, 2.0d], Health: 20.0f}", nil)
+	data, err := tc.mc.DataGetEntity(Player("test"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := nbt.Compound{
+		"Pos":    nbt.List{nbt.Double(1), nbt.Double(64), nbt.Double(2)},
+		"Health": nbt.Float(20),
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("\nExpected: %v\nGot:      %v", expected, data)
+	}
+
+	tc.client.EXPECT().Request("data get entity @e[type=minecraft:cow,limit=1] Pos[1]").
+		Return("Cow has the following entity data: 64.0d", nil)
+	data, err = tc.mc.DataGetEntity(NewSelector(SelectorAllEntities).
+		Type("minecraft:cow").Limit(1), "Pos[1]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != nbt.Double(64) {
+		t.Errorf("Expected: 64.0d Got: %v", data)
+	}
+}
+
+func TestDataGetEntityNameContainsMarker(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity @e[tag=sign,limit=1]").
+		Return("Bob has the following entity data: {fake:1} has the "+
+			"following entity data: {Health:20.0f}", nil)
+	data, err := tc.mc.DataGetEntity(NewSelector(SelectorAllEntities).
+		Tag("sign").Limit(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := nbt.Compound{"Health": nbt.Float(20)}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("\nExpected: %v\nGot:      %v", expected, data)
+	}
+}
+
+func TestDataGetEntityPlayerDisplayName(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	type testCase struct {
+		name     string
+		response string
+	}
+	testCases := []testCase{
+		{"TeamPrefix",
+			"[Red] Steve * has the following entity data: {Health:20.0f}"},
+		{"Case", "Steve has the following entity data: {Health:20.0f}"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			tc.client.EXPECT().Request("data get entity steve").
+				Return(tcase.response, nil)
+			data, err := tc.mc.DataGetEntity(Player("steve"), "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := nbt.Compound{"Health": nbt.Float(20)}
+			if !reflect.DeepEqual(data, expected) {
+				t.Errorf("\nExpected: %v\nGot:      %v", expected, data)
+			}
+		})
+	}
+}
+
+func TestDataGetEntityErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test").
+		Return("No entity was found", nil)
+	_, err := tc.mc.DataGetEntity(Player("test"), "")
+	expectError(t, err, "No entity was found")
+
+	_, err = tc.mc.DataGetEntity(Player("test"), "Pos[")
+	expectError(t, err, "expected ']'")
+}
+
+func TestDataGetBlockSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get block 1 -60 2 Items").
+		Return("1, -60, 2 has the following block data: "+
+			`[{Slot: 0b, id: "minecraft:stone", count: 1}]`, nil)
+	data, err := tc.mc.DataGetBlock(1, -60, 2, "Items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := nbt.List{nbt.Compound{
+		"Slot":  nbt.Byte(0),
+		"id":    nbt.String("minecraft:stone"),
+		"count": nbt.Int(1),
+	}}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("\nExpected: %v\nGot:      %v", expected, data)
+	}
+}
+
+func TestDataGetStorageSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get storage event:state").
+		Return("Storage event:state has the following contents: "+
+			`{round: 3, name: "Finals"}`, nil)
+	data, err := tc.mc.DataGetStorage("event:state", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := nbt.Compound{
+		"round": nbt.Int(3),
+		"name":  nbt.String("Finals"),
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("\nExpected: %v\nGot:      %v", expected, data)
+	}
+}
+
+func TestDataGetStorageDefaultNamespace(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get storage state").
+		Return("Storage minecraft:state has the following contents: {round:3}",
+			nil)
+	data, err := tc.mc.DataGetStorage("state", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := nbt.Compound{"round": nbt.Int(3)}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("\nExpected: %v\nGot:      %v", expected, data)
+	}
+}
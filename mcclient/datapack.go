@@ -0,0 +1,144 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	bracketedRegex       *regexp.Regexp = regexp.MustCompile(`\[([^\]]*)\]`)
+	datapackSectionRegex *regexp.Regexp = regexp.MustCompile(
+		`There (?:are|is) (?:no |no more |\d+ )data packs?(?:\(s\))? ` +
+			`(enabled|available)`)
+	datapackSourceRegex *regexp.Regexp = regexp.MustCompile(
+		`^(.*?) \([^()]*\)$`)
+	functionRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:Executed (\d+) commands?|Function .+ returned (-?\d+))`)
+	scheduleClearRegex *regexp.Regexp = regexp.MustCompile(
+		`^Removed (\d+) schedules?`)
+)
+
+// ScheduleMode is what happens when a function is scheduled while it is
+// already scheduled.
+type ScheduleMode string
+
+const (
+	// ScheduleReplace replaces the existing schedule.
+	ScheduleReplace ScheduleMode = "replace"
+	// ScheduleAppend schedules the function again.
+	ScheduleAppend ScheduleMode = "append"
+)
+
+func newDatapackCommand(subcommand string) *client.Command {
+	return client.NewCommand("datapack").Literal(subcommand)
+}
+
+// DatapackList returns the names of the enabled data packs, in load order,
+// and of the available packs that are not enabled.
+func (mc *MinecraftClient) DatapackList() ([]string, []string, error) {
+	resp, err := mc.request(newDatapackCommand("list"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sections := datapackSectionRegex.FindAllStringSubmatchIndex(resp, -1)
+	if len(sections) == 0 || sections[0][0] != 0 {
+		return nil, nil, newResponseError(resp)
+	}
+	enabled := []string{}
+	available := []string{}
+	for i, section := range sections {
+		end := len(resp)
+		if i+1 < len(sections) {
+			end = sections[i+1][0]
+		}
+		names := []string{}
+		for _, match := range bracketedRegex.FindAllStringSubmatch(
+			resp[section[1]:end], -1) {
+			name := match[1]
+			if source := datapackSourceRegex.FindStringSubmatch(name); source != nil {
+				name = source[1]
+			}
+			names = append(names, name)
+		}
+		if resp[section[2]:section[3]] == "enabled" {
+			enabled = names
+		} else {
+			available = names
+		}
+	}
+	return enabled, available, nil
+}
+
+// DatapackEnable enables the data pack name, such as file/example.zip, so
+// that it loads after every other pack.
+func (mc *MinecraftClient) DatapackEnable(name string) error {
+	return mc.simpleRequest(newDatapackCommand("enable").Quoted(name),
+		"Enabling pack")
+}
+
+// DatapackDisable disables the data pack name.
+func (mc *MinecraftClient) DatapackDisable(name string) error {
+	return mc.simpleRequest(newDatapackCommand("disable").Quoted(name),
+		"Disabling pack")
+}
+
+// Function runs function, such as example:tick, or every function in a tag
+// such as #example:setup. It returns how many commands ran or, on servers
+// that support return, the value the function returned.
+func (mc *MinecraftClient) Function(function string) (int, error) {
+	resp, err := mc.request(client.NewCommand("function").Literal(function))
+	if err != nil {
+		return 0, err
+	}
+	matches, err := parseResponse(resp, functionRegex)
+	if err != nil {
+		return 0, err
+	}
+	count := matches[1]
+	if count == "" {
+		count = matches[2]
+	}
+	return strconv.Atoi(count)
+}
+
+// ScheduleFunction runs function after delay, which is rounded down to whole
+// ticks and must be at least one tick.
+func (mc *MinecraftClient) ScheduleFunction(function string,
+	delay time.Duration, mode ScheduleMode) error {
+	ticks := durationTicks(delay)
+	if ticks < 1 {
+		return fmt.Errorf("invalid delay: %v", delay)
+	}
+	switch mode {
+	case ScheduleReplace, ScheduleAppend:
+	default:
+		return fmt.Errorf("invalid schedule mode: %s", mode)
+	}
+	return mc.simpleRequest(client.NewCommand("schedule").Literal("function").
+		Literal(function).Literal(fmt.Sprintf("%dt", ticks)).
+		Literal(string(mode)), "Scheduled function")
+}
+
+// ScheduleClear cancels every schedule of function and returns how many were
+// cancelled.
+func (mc *MinecraftClient) ScheduleClear(function string) (int, error) {
+	resp, err := mc.request(client.NewCommand("schedule").Literal("clear").
+		Literal(function))
+	if err != nil {
+		return 0, err
+	}
+	if strings.HasPrefix(resp, "No schedules") {
+		return 0, nil
+	}
+	return parseResponseInt(resp, scheduleClearRegex)
+}
+
+// Reload reloads data packs, functions and loot tables.
+func (mc *MinecraftClient) Reload() error {
+	return mc.simpleRequest(client.NewCommand("reload"), "Reloading!")
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDatapackList(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	type testCase struct {
+		name      string
+		response  string
+		enabled   []string
+		available []string
+	}
+	testCases := []testCase{
+		{"Both",
+			"There are 2 data pack(s) enabled: [vanilla (built-in)], " +
+				"[file/example.zip (world)]There are 1 data pack(s) available: " +
+				"[file/old (world)]",
+			[]string{"vanilla", "file/example.zip"}, []string{"file/old"}},
+		{"NoneAvailable",
+			"There are 1 data pack(s) enabled: [vanilla (built-in)]" +
+				"There are no more data packs available",
+			[]string{"vanilla"}, []string{}},
+		{"NoneEnabled",
+			"There are no data packs enabled" +
+				"There are 1 data pack(s) available: [vanilla (built-in)]",
+			[]string{}, []string{"vanilla"}},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			tc.client.EXPECT().Request("datapack list").Return(tcase.response, nil)
+			enabled, available, err := tc.mc.DatapackList()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(enabled, tcase.enabled) {
+				t.Errorf("Expected: %v Got: %v", tcase.enabled, enabled)
+			}
+			if !reflect.DeepEqual(available, tcase.available) {
+				t.Errorf("Expected: %v Got: %v", tcase.available, available)
+			}
+		})
+	}
+}
+
+func TestDatapackEnableDisable(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`datapack enable "file/example.zip"`).
+		Return("Enabling pack [file/example.zip (world)]", nil)
+	if err := tc.mc.DatapackEnable("file/example.zip"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`datapack disable "file/example.zip"`).
+		Return("Disabling pack [file/example.zip (world)]", nil)
+	if err := tc.mc.DatapackDisable("file/example.zip"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`datapack enable "file/missing"`).
+		Return("Unknown data pack 'file/missing'", nil)
+	err := tc.mc.DatapackEnable("file/missing")
+	if !errors.Is(err, ErrUnknownDatapack) {
+		t.Errorf("Expected: %v Got: %v", ErrUnknownDatapack, err)
+	}
+}
+
+func TestFunction(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("function example:setup").
+		Return("Executed 12 commands from function 'example:setup'", nil)
+	count, err := tc.mc.Function("example:setup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 12 {
+		t.Errorf("Expected: 12 Got: %d", count)
+	}
+	tc.client.EXPECT().Request("function example:count").
+		Return("Function example:count returned -3", nil)
+	count, err = tc.mc.Function("example:count")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != -3 {
+		t.Errorf("Expected: -3 Got: %d", count)
+	}
+	tc.client.EXPECT().Request("function example:missing").
+		Return("Unknown function example:missing", nil)
+	_, err = tc.mc.Function("example:missing")
+	if !errors.Is(err, ErrUnknownFunction) {
+		t.Errorf("Expected: %v Got: %v", ErrUnknownFunction, err)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("schedule function example:tick 100t append").
+		Return("Scheduled function 'example:tick' in 100 ticks at gametime "+
+			"48211", nil)
+	err := tc.mc.ScheduleFunction("example:tick", 5*time.Second,
+		ScheduleAppend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tc.mc.ScheduleFunction("example:tick", 0, ScheduleAppend)
+	expectError(t, err, "invalid delay: 0s")
+	err = tc.mc.ScheduleFunction("example:tick", time.Second,
+		ScheduleMode("merge"))
+	expectError(t, err, "invalid schedule mode: merge")
+
+	tc.client.EXPECT().Request("schedule clear example:tick").
+		Return("Removed 2 schedules with id example:tick", nil)
+	count, err := tc.mc.ScheduleClear("example:tick")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("Expected: 2 Got: %d", count)
+	}
+	tc.client.EXPECT().Request("schedule clear example:tick").
+		Return("No schedules with id example:tick", nil)
+	count, err = tc.mc.ScheduleClear("example:tick")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("Expected: 0 Got: %d", count)
+	}
+}
+
+func TestReload(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("reload").Return("Reloading!", nil)
+	if err := tc.mc.Reload(); err != nil {
+		t.Fatal(err)
+	}
+}
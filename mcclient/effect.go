@@ -0,0 +1,46 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+// EffectInfinite makes an effect last until it is cleared.
+const EffectInfinite time.Duration = -1
+
+func newEffectCommand(subcommand string, target Target) *client.Command {
+	return client.NewCommand("effect").Literal(subcommand).Arg(target)
+}
+
+// EffectGive applies effect, such as minecraft:speed, to the targeted
+// entities for duration, which is rounded down to whole seconds. Amplifier 0
+// is level I of the effect.
+func (mc *MinecraftClient) EffectGive(target Target, effect string,
+	duration time.Duration, amplifier int, hideParticles bool) error {
+	if amplifier < 0 || amplifier > 255 {
+		return fmt.Errorf("invalid amplifier: %d", amplifier)
+	}
+	cmd := newEffectCommand("give", target).Literal(effect)
+	switch {
+	case duration == EffectInfinite:
+		cmd.Literal("infinite")
+	case duration >= time.Second:
+		cmd.Int(int(duration / time.Second))
+	default:
+		return fmt.Errorf("invalid duration: %v", duration)
+	}
+	return mc.simpleRequest(cmd.Int(amplifier).Bool(hideParticles),
+		"Applied effect")
+}
+
+// EffectClear removes effect from the targeted entities. An empty effect
+// removes every effect.
+func (mc *MinecraftClient) EffectClear(target Target, effect string) error {
+	cmd := newEffectCommand("clear", target)
+	if effect != "" {
+		cmd.Literal(effect)
+	}
+	return mc.simpleRequest(cmd, "Removed")
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEffectGiveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("effect give test minecraft:speed 30 1 true").
+		Return("Applied effect Speed to test", nil)
+	err := tc.mc.EffectGive(Player("test"), "minecraft:speed",
+		30*time.Second, 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(
+		"effect give @a minecraft:night_vision infinite 0 false").
+		Return("Applied effect Night Vision to 3 targets", nil)
+	err = tc.mc.EffectGive(NewSelector(SelectorAllPlayers),
+		"minecraft:night_vision", EffectInfinite, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEffectGiveFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.EffectGive(Player("test"), "minecraft:speed",
+		500*time.Millisecond, 0, false)
+	expectError(t, err, "invalid duration: 500ms")
+	err = tc.mc.EffectGive(Player("test"), "minecraft:speed", time.Second,
+		256, false)
+	expectError(t, err, "invalid amplifier: 256")
+
+	tc.client.EXPECT().Request("effect give test minecraft:speed 10 0 false").
+		Return("Unable to apply this effect (target is either immune to "+
+			"effects, or has something stronger)", nil)
+	err = tc.mc.EffectGive(Player("test"), "minecraft:speed",
+		10*time.Second, 0, false)
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+}
+
+func TestEffectClearSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("effect clear test").
+		Return("Removed every effect from test", nil)
+	if err := tc.mc.EffectClear(Player("test"), ""); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("effect clear test minecraft:poison").
+		Return("Removed effect Poison from test", nil)
+	if err := tc.mc.EffectClear(Player("test"), "minecraft:poison"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEffectClearNoEffects(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("effect clear test").
+		Return("Target has no effects to remove", nil)
+	err := tc.mc.EffectClear(Player("test"), "")
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+var (
+	killCountRegex *regexp.Regexp = regexp.MustCompile(
+		`^Killed (\d+) entities`)
+)
+
+// Summon spawns an entity of entityType, such as minecraft:zombie, at pos.
+// Data, if not empty, sets the new entity's NBT.
+func (mc *MinecraftClient) Summon(entityType string, pos Vec3,
+	data nbt.Compound) error {
+	if err := validateResource(entityType); err != nil {
+		return err
+	}
+	cmd := client.NewCommand("summon").Literal(entityType).Arg(pos)
+	if len(data) > 0 {
+		cmd.Arg(rawArgument(data.String()))
+	}
+	return mc.simpleRequest(cmd, "Summoned new")
+}
+
+// Kill kills the targeted entities and returns how many were killed. A target
+// that matches nothing kills 0 entities rather than returning an error.
+func (mc *MinecraftClient) Kill(target Target) (int, error) {
+	resp, err := mc.request(client.NewCommand("kill").Arg(target))
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasPrefix(resp, "Killed") {
+		err = newResponseError(resp)
+		if errors.Is(err, ErrNoEntity) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if killCountRegex.MatchString(resp) {
+		return parseResponseInt(resp, killCountRegex)
+	}
+	return 1, nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+func TestSummonSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		`summon minecraft:zombie 10 64 -5 {CustomName:"\"Bob\"",NoAI:1b,`+
+			`Tags:["event"]}`).
+		Return("Summoned new Zombie", nil)
+	err := tc.mc.Summon("minecraft:zombie", Pos(10, 64, -5), nbt.NewCompound().
+		SetString("CustomName", `"Bob"`).
+		SetBool("NoAI", true).
+		Set("Tags", nbt.Strings("event")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("summon minecraft:lightning_bolt ~ ~ ~").
+		Return("Summoned new Lightning Bolt", nil)
+	err = tc.mc.Summon("minecraft:lightning_bolt", Here(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSummonFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.Summon("minecraft:zombie; stop", Here(), nil)
+	expectError(t, err, "invalid resource location")
+
+	tc.client.EXPECT().Request("summon minecraft:zombie 0 -100 0").
+		Return("Invalid position for summon", nil)
+	err = tc.mc.Summon("minecraft:zombie", Pos(0, -100, 0), nil)
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected: %v Got: %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestKillSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	type testCase struct {
+		response string
+		count    int
+	}
+	testCases := []testCase{
+		{"Killed 12 entities", 12},
+		{"Killed Zombie", 1},
+		{"No entity was found", 0},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.response, func(t *testing.T) {
+			tc.client.EXPECT().Request("kill @e[type=minecraft:zombie]").
+				Return(tcase.response, nil)
+			count, err := tc.mc.Kill(NewSelector(SelectorAllEntities).
+				Type("minecraft:zombie"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tcase.count {
+				t.Errorf("Expected: %d Got: %d", tcase.count, count)
+			}
+		})
+	}
+}
+
+func TestKillFailure(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("kill @e[type=zombie]").
+		Return("Unknown or incomplete command, see below for error", nil)
+	_, err := tc.mc.Kill(NewSelector(SelectorAllEntities).Type("zombie"))
+	if !errors.Is(err, ErrUnknownCommand) {
+		t.Errorf("Expected: %v Got: %v", ErrUnknownCommand, err)
+	}
+}
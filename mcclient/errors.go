@@ -0,0 +1,118 @@
+package client
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrNothingChanged is wrapped by a ResponseError when a command had no
+	// effect, for example because the value was already set.
+	ErrNothingChanged = errors.New("nothing changed")
+	// ErrUnknownCommand is wrapped by a ResponseError when the server did not
+	// recognize the command.
+	ErrUnknownCommand = errors.New("unknown command")
+	// ErrInvalidArgument is wrapped by a ResponseError when the server rejected
+	// an argument to the command.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrUnknownObjective is wrapped by a ResponseError when a scoreboard
+	// objective does not exist.
+	ErrUnknownObjective = errors.New("unknown objective")
+	// ErrNoScore is wrapped by a ResponseError when an entity has no score for
+	// an objective.
+	ErrNoScore = errors.New("no score")
+	// ErrAlreadyExists is wrapped by a ResponseError when creating something,
+	// such as an objective, that already exists.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrUnknownTeam is wrapped by a ResponseError when a team does not exist.
+	ErrUnknownTeam = errors.New("unknown team")
+	// ErrUnknownBossbar is wrapped by a ResponseError when a bossbar does not
+	// exist.
+	ErrUnknownBossbar = errors.New("unknown bossbar")
+	// ErrNoEntity is wrapped by a ResponseError when a target did not match
+	// any entities or players.
+	ErrNoEntity = errors.New("no entity found")
+	// ErrTooManyBlocks is wrapped by a ResponseError when a region is larger
+	// than the server allows a single command to change.
+	ErrTooManyBlocks = errors.New("too many blocks")
+	// ErrTooManyChunks is wrapped by a ResponseError when a region is larger
+	// than the server allows a single forceload command to change.
+	ErrTooManyChunks = errors.New("too many chunks")
+	// ErrNotFound is wrapped by a ResponseError when locate could not find
+	// anything within range.
+	ErrNotFound = errors.New("not found")
+	// ErrUnknownFunction is wrapped by a ResponseError when a function does
+	// not exist.
+	ErrUnknownFunction = errors.New("unknown function")
+	// ErrUnknownDatapack is wrapped by a ResponseError when a data pack does
+	// not exist.
+	ErrUnknownDatapack = errors.New("unknown data pack")
+	// ErrNotLoaded is wrapped by a ResponseError when a position is in a chunk
+	// that is not loaded.
+	ErrNotLoaded = errors.New("position not loaded")
+)
+
+// knownErrors maps the prefixes of common failure responses to their errors.
+var knownErrors = []struct {
+	prefix string
+	err    error
+}{
+	{"Nothing changed", ErrNothingChanged},
+	{"Unknown or incomplete command", ErrUnknownCommand},
+	{"Incorrect argument for command", ErrInvalidArgument},
+	{"Invalid", ErrInvalidArgument},
+	{"Unknown scoreboard objective", ErrUnknownObjective},
+	{"Can't get value of", ErrNoScore},
+	{"An objective already exists", ErrAlreadyExists},
+	{"A team already exists", ErrAlreadyExists},
+	{"Unknown team", ErrUnknownTeam},
+	{"A bossbar already exists", ErrAlreadyExists},
+	{"No bossbar exists", ErrUnknownBossbar},
+	{"No entity was found", ErrNoEntity},
+	{"No player was found", ErrNoEntity},
+	{"Unable to apply this effect", ErrNothingChanged},
+	{"Target has no effects to remove", ErrNothingChanged},
+	{"Target doesn't have the requested effect", ErrNothingChanged},
+	{"Couldn't grant", ErrNothingChanged},
+	{"Couldn't revoke", ErrNothingChanged},
+	{"Could not set the block", ErrNothingChanged},
+	{"No blocks were", ErrNothingChanged},
+	{"Too many blocks in the specified area", ErrTooManyBlocks},
+	{"That position is not loaded", ErrNotLoaded},
+	{"No chunks were", ErrNothingChanged},
+	{"Too many chunks in the specified area", ErrTooManyChunks},
+	{"Could not find", ErrNotFound},
+	{"Unknown function", ErrUnknownFunction},
+	{"Unknown data pack", ErrUnknownDatapack},
+}
+
+// ResponseError is returned when the server responds with something other
+// than the expected response. If the response is a known failure, Err is set
+// so that it can be checked with errors.Is.
+type ResponseError struct {
+	Response string
+	Err      error
+}
+
+func newResponseError(response string) error {
+	respErr := &ResponseError{
+		Response: response,
+	}
+	for _, known := range knownErrors {
+		if strings.HasPrefix(response, known.prefix) {
+			respErr.Err = known.err
+			break
+		}
+	}
+	return respErr
+}
+
+// Error returns the response from the server.
+func (err *ResponseError) Error() string {
+	return err.Response
+}
+
+// Unwrap returns the known failure, if there is one.
+func (err *ResponseError) Unwrap() error {
+	return err.Err
+}
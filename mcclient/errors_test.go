@@ -0,0 +1,68 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResponseErrorKnown(t *testing.T) {
+	type testCase struct {
+		response string
+		err      error
+	}
+	testCases := []testCase{
+		{"Nothing changed. The player is already banned", ErrNothingChanged},
+		{"Unknown or incomplete command, see below for error", ErrUnknownCommand},
+		{"Incorrect argument for command", ErrInvalidArgument},
+		{"Invalid integer '1.5'", ErrInvalidArgument},
+		{"Unknown scoreboard objective 'kills'", ErrUnknownObjective},
+		{"Can't get value of kills for test; none is set", ErrNoScore},
+		{"An objective already exists by that name", ErrAlreadyExists},
+		{"A team already exists by that name", ErrAlreadyExists},
+		{"Unknown team 'red'", ErrUnknownTeam},
+		{"A bossbar already exists with the ID 'timer'", ErrAlreadyExists},
+		{"No bossbar exists with the ID 'timer'", ErrUnknownBossbar},
+		{"No entity was found", ErrNoEntity},
+		{"No player was found", ErrNoEntity},
+		{"Unable to apply this effect (target is either immune to effects, " +
+			"or has something stronger)", ErrNothingChanged},
+		{"Target has no effects to remove", ErrNothingChanged},
+		{"Target doesn't have the requested effect", ErrNothingChanged},
+		{"Couldn't grant advancement Diamonds! to test as they already have it",
+			ErrNothingChanged},
+		{"Couldn't revoke 3 advancements from test as they don't have them",
+			ErrNothingChanged},
+		{"Could not set the block", ErrNothingChanged},
+		{"No blocks were filled", ErrNothingChanged},
+		{"No blocks were cloned", ErrNothingChanged},
+		{"Too many blocks in the specified area (maximum 32768, specified 40000)",
+			ErrTooManyBlocks},
+		{"That position is not loaded", ErrNotLoaded},
+		{"No chunks were marked for force loading", ErrNothingChanged},
+		{"Too many chunks in the specified area (maximum 256, specified 400)",
+			ErrTooManyChunks},
+		{`Could not find a structure of type "minecraft:mansion" nearby`,
+			ErrNotFound},
+		{"Unknown function example:missing", ErrUnknownFunction},
+		{"Unknown data pack 'file/missing'", ErrUnknownDatapack},
+		{"Something else", nil},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.response, func(t *testing.T) {
+			err := newResponseError(tcase.response)
+			if err.Error() != tcase.response {
+				t.Errorf("Expected: %s Got: %v", tcase.response, err)
+			}
+			var respErr *ResponseError
+			if !errors.As(err, &respErr) {
+				t.Fatalf("Expected a ResponseError: %T", err)
+			}
+			if respErr.Err != tcase.err {
+				t.Errorf("Expected: %v Got: %v", tcase.err, respErr.Err)
+			}
+			if tcase.err != nil && !errors.Is(err, tcase.err) {
+				t.Errorf("Expected errors.Is(%v)", tcase.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,476 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+var (
+	alignRegex *regexp.Regexp = regexp.MustCompile(`^[xyz]{1,3}$`)
+	testRegex  *regexp.Regexp = regexp.MustCompile(
+		`^Test passed(?:, count: (\d+))?`)
+)
+
+// executeStorage is the command storage ExecuteResult stores results in.
+const executeStorage = "rcon:execute"
+
+// Anchor is the part of an entity that positions are relative to.
+type Anchor string
+
+const (
+	// AnchorFeet anchors at the entity's feet.
+	AnchorFeet Anchor = "feet"
+	// AnchorEyes anchors at the entity's eyes.
+	AnchorEyes Anchor = "eyes"
+)
+
+// CommandArgument validates and returns the anchor.
+func (anchor Anchor) CommandArgument() (string, error) {
+	switch anchor {
+	case AnchorFeet, AnchorEyes:
+		return string(anchor), nil
+	}
+	return "", fmt.Errorf("invalid anchor: %s", string(anchor))
+}
+
+// ScoreComparison compares two scores in a score condition.
+type ScoreComparison string
+
+const (
+	// ScoreLess passes if the target score is less than the source score.
+	ScoreLess ScoreComparison = "<"
+	// ScoreLessEqual passes if the target score is at most the source score.
+	ScoreLessEqual ScoreComparison = "<="
+	// ScoreEqual passes if the scores are equal.
+	ScoreEqual ScoreComparison = "="
+	// ScoreGreaterEqual passes if the target score is at least the source
+	// score.
+	ScoreGreaterEqual ScoreComparison = ">="
+	// ScoreGreater passes if the target score is more than the source score.
+	ScoreGreater ScoreComparison = ">"
+)
+
+// CommandArgument validates and returns the comparison.
+func (cmp ScoreComparison) CommandArgument() (string, error) {
+	switch cmp {
+	case ScoreLess, ScoreLessEqual, ScoreEqual, ScoreGreaterEqual,
+		ScoreGreater:
+		return string(cmp), nil
+	}
+	return "", fmt.Errorf("invalid score comparison: %s", string(cmp))
+}
+
+// StoreType is the NBT type a result is stored as.
+type StoreType string
+
+const (
+	// StoreByte stores the result as a byte.
+	StoreByte StoreType = "byte"
+	// StoreShort stores the result as a short.
+	StoreShort StoreType = "short"
+	// StoreInt stores the result as an int.
+	StoreInt StoreType = "int"
+	// StoreLong stores the result as a long.
+	StoreLong StoreType = "long"
+	// StoreFloat stores the result as a float.
+	StoreFloat StoreType = "float"
+	// StoreDouble stores the result as a double.
+	StoreDouble StoreType = "double"
+)
+
+// CommandArgument validates and returns the type.
+func (typ StoreType) CommandArgument() (string, error) {
+	switch typ {
+	case StoreByte, StoreShort, StoreInt, StoreLong, StoreFloat, StoreDouble:
+		return string(typ), nil
+	}
+	return "", fmt.Errorf("invalid store type: %s", string(typ))
+}
+
+// BossbarField is the part of a bossbar a result is stored in.
+type BossbarField string
+
+const (
+	// BossbarValue stores the result as the bossbar's value.
+	BossbarValue BossbarField = "value"
+	// BossbarMax stores the result as the bossbar's maximum.
+	BossbarMax BossbarField = "max"
+)
+
+// CommandArgument validates and returns the field.
+func (field BossbarField) CommandArgument() (string, error) {
+	switch field {
+	case BossbarValue, BossbarMax:
+		return string(field), nil
+	}
+	return "", fmt.Errorf("invalid bossbar field: %s", string(field))
+}
+
+// alignAxes is a set of axes that are each used at most once.
+type alignAxes string
+
+// CommandArgument validates and returns the axes.
+func (axes alignAxes) CommandArgument() (string, error) {
+	str := string(axes)
+	if !alignRegex.MatchString(str) || strings.Count(str, "x") > 1 ||
+		strings.Count(str, "y") > 1 || strings.Count(str, "z") > 1 {
+		return "", fmt.Errorf("invalid axes: %q", str)
+	}
+	return str, nil
+}
+
+// nbtPath is an NBT path that is validated when it is rendered.
+type nbtPath string
+
+// CommandArgument parses and formats the path.
+func (path nbtPath) CommandArgument() (string, error) {
+	parsed, err := nbt.ParsePath(string(path))
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// scoreRange is a Range that must be made of whole numbers.
+type scoreRange Range
+
+// CommandArgument validates and formats the range.
+func (r scoreRange) CommandArgument() (string, error) {
+	if err := Range(r).validate(true); err != nil {
+		return "", err
+	}
+	return Range(r).String(), nil
+}
+
+// Condition is a test for Execute.If and Execute.Unless.
+type Condition struct {
+	cmd *client.Command
+}
+
+// CommandArgument renders the condition.
+func (cond Condition) CommandArgument() (string, error) {
+	if cond.cmd == nil {
+		return "", fmt.Errorf("missing condition")
+	}
+	return cond.cmd.Build()
+}
+
+// BlockCondition passes if the block at pos matches block.
+func BlockCondition(pos Vec3, block BlockState) Condition {
+	return Condition{client.NewCommand("block").Arg(blockPos(pos)).Arg(block)}
+}
+
+// EntityCondition passes if target matches at least one entity.
+func EntityCondition(target Target) Condition {
+	return Condition{client.NewCommand("entity").Arg(target)}
+}
+
+// ScoreCondition passes if the score of target for objective compares to
+// the score of source for sourceObjective.
+func ScoreCondition(target Target, objective string, cmp ScoreComparison,
+	source Target, sourceObjective string) Condition {
+	return Condition{client.NewCommand("score").Arg(target).Literal(objective).
+		Arg(cmp).Arg(source).Literal(sourceObjective)}
+}
+
+// ScoreMatchesCondition passes if the score of target for objective is in r.
+func ScoreMatchesCondition(target Target, objective string,
+	r Range) Condition {
+	return Condition{client.NewCommand("score").Arg(target).Literal(objective).
+		Literal("matches").Arg(scoreRange(r))}
+}
+
+// DataEntityCondition passes if path matches anything in the data of target.
+func DataEntityCondition(target Target, path string) Condition {
+	return Condition{client.NewCommand("data").Literal("entity").Arg(target).
+		Arg(nbtPath(path))}
+}
+
+// DataBlockCondition passes if path matches anything in the data of the
+// block entity at pos.
+func DataBlockCondition(pos Vec3, path string) Condition {
+	return Condition{client.NewCommand("data").Literal("block").
+		Arg(blockPos(pos)).Arg(nbtPath(path))}
+}
+
+// DataStorageCondition passes if path matches anything in the command
+// storage id.
+func DataStorageCondition(id, path string) Condition {
+	return Condition{client.NewCommand("data").Literal("storage").Literal(id).
+		Arg(nbtPath(path))}
+}
+
+// PredicateCondition passes if the predicate id, from a data pack, passes.
+func PredicateCondition(id string) Condition {
+	return Condition{client.NewCommand("predicate").Literal(id)}
+}
+
+// StoreTarget is where Execute.StoreResult and Execute.StoreSuccess put
+// their value.
+type StoreTarget struct {
+	cmd *client.Command
+}
+
+// CommandArgument renders the store target.
+func (store StoreTarget) CommandArgument() (string, error) {
+	if store.cmd == nil {
+		return "", fmt.Errorf("missing store target")
+	}
+	return store.cmd.Build()
+}
+
+// StoreScore stores the value as the score of target for objective.
+func StoreScore(target Target, objective string) StoreTarget {
+	return StoreTarget{client.NewCommand("score").Arg(target).
+		Literal(objective)}
+}
+
+// StoreBossbar stores the value in field of the bossbar id.
+func StoreBossbar(id string, field BossbarField) StoreTarget {
+	return StoreTarget{client.NewCommand("bossbar").Literal(id).Arg(field)}
+}
+
+// storeData appends a path, type and scale to a data store target.
+func storeData(cmd *client.Command, path string, typ StoreType,
+	scale float64) StoreTarget {
+	return StoreTarget{cmd.Arg(nbtPath(path)).Arg(typ).Float(scale)}
+}
+
+// StoreStorage stores the value, multiplied by scale, at path in the command
+// storage id.
+func StoreStorage(id, path string, typ StoreType,
+	scale float64) StoreTarget {
+	return storeData(client.NewCommand("storage").Literal(id), path, typ, scale)
+}
+
+// StoreEntity stores the value, multiplied by scale, at path in the data of
+// a single target.
+func StoreEntity(target Target, path string, typ StoreType,
+	scale float64) StoreTarget {
+	return storeData(client.NewCommand("entity").Arg(target), path, typ, scale)
+}
+
+// StoreBlock stores the value, multiplied by scale, at path in the data of
+// the block entity at pos.
+func StoreBlock(pos Vec3, path string, typ StoreType,
+	scale float64) StoreTarget {
+	return storeData(client.NewCommand("block").Arg(blockPos(pos)), path, typ,
+		scale)
+}
+
+// Execute builds an execute command. Subcommands are rendered in the order
+// they are added and Run, if used, must be last.
+type Execute struct {
+	cmd *client.Command
+	run bool
+}
+
+// NewExecute starts a new execute command.
+func NewExecute() *Execute {
+	return &Execute{
+		cmd: client.NewCommand("execute"),
+	}
+}
+
+// As runs as each of the targeted entities.
+func (e *Execute) As(target Target) *Execute {
+	e.cmd.Literal("as").Arg(target)
+	return e
+}
+
+// At runs at the position, rotation and dimension of each targeted entity.
+func (e *Execute) At(target Target) *Execute {
+	e.cmd.Literal("at").Arg(target)
+	return e
+}
+
+// Positioned runs at pos.
+func (e *Execute) Positioned(pos Vec3) *Execute {
+	e.cmd.Literal("positioned").Arg(pos)
+	return e
+}
+
+// PositionedAs runs at the position of each targeted entity.
+func (e *Execute) PositionedAs(target Target) *Execute {
+	e.cmd.Literal("positioned").Literal("as").Arg(target)
+	return e
+}
+
+// Rotated runs with rot.
+func (e *Execute) Rotated(rot Rotation) *Execute {
+	e.cmd.Literal("rotated").Arg(rot)
+	return e
+}
+
+// RotatedAs runs with the rotation of each targeted entity.
+func (e *Execute) RotatedAs(target Target) *Execute {
+	e.cmd.Literal("rotated").Literal("as").Arg(target)
+	return e
+}
+
+// Facing runs rotated to face pos.
+func (e *Execute) Facing(pos Vec3) *Execute {
+	e.cmd.Literal("facing").Arg(pos)
+	return e
+}
+
+// FacingEntity runs rotated to face anchor of each targeted entity.
+func (e *Execute) FacingEntity(target Target, anchor Anchor) *Execute {
+	e.cmd.Literal("facing").Literal("entity").Arg(target).
+		Arg(anchor)
+	return e
+}
+
+// In runs in dimension, such as minecraft:the_nether.
+func (e *Execute) In(dimension string) *Execute {
+	e.cmd.Literal("in").Literal(dimension)
+	return e
+}
+
+// Anchored makes local coordinates relative to anchor of the executing
+// entity.
+func (e *Execute) Anchored(anchor Anchor) *Execute {
+	e.cmd.Literal("anchored").Arg(anchor)
+	return e
+}
+
+// Align rounds the position down to whole blocks along axes, such as xz.
+func (e *Execute) Align(axes string) *Execute {
+	e.cmd.Literal("align").Arg(alignAxes(axes))
+	return e
+}
+
+// If only continues if cond passes.
+func (e *Execute) If(cond Condition) *Execute {
+	e.cmd.Literal("if").Arg(cond)
+	return e
+}
+
+// Unless only continues if cond fails.
+func (e *Execute) Unless(cond Condition) *Execute {
+	e.cmd.Literal("unless").Arg(cond)
+	return e
+}
+
+// StoreResult stores the result of the command in store.
+func (e *Execute) StoreResult(store StoreTarget) *Execute {
+	e.cmd.Literal("store").Literal("result").Arg(store)
+	return e
+}
+
+// StoreSuccess stores 1 in store if the command succeeded, or 0 otherwise.
+func (e *Execute) StoreSuccess(store StoreTarget) *Execute {
+	e.cmd.Literal("store").Literal("success").Arg(store)
+	return e
+}
+
+// Run runs cmd in the context built so far.
+func (e *Execute) Run(cmd *client.Command) *Execute {
+	e.cmd.Literal("run").Arg(cmd)
+	e.run = true
+	return e
+}
+
+// Command returns the execute command, to be sent or nested in another.
+func (e *Execute) Command() *client.Command {
+	return e.cmd
+}
+
+// CommandArgument renders the execute command.
+func (e *Execute) CommandArgument() (string, error) {
+	return e.cmd.Build()
+}
+
+// Execute sends e and returns the server's response, which is the response
+// of the last command run.
+func (mc *MinecraftClient) Execute(e *Execute) (string, error) {
+	return mc.request(e.Command())
+}
+
+// ExecuteTest sends e, which must end in a condition rather than Run, and
+// returns how many entities passed it. A failed test returns 0.
+func (mc *MinecraftClient) ExecuteTest(e *Execute) (int, error) {
+	resp, err := mc.Execute(e)
+	if err != nil {
+		return 0, err
+	}
+	if strings.HasPrefix(resp, "Test failed") {
+		return 0, nil
+	}
+	matches, err := parseResponse(resp, testRegex)
+	if err != nil {
+		return 0, err
+	}
+	if matches[1] == "" {
+		return 1, nil
+	}
+	return strconv.Atoi(matches[1])
+}
+
+// ExecuteResult sends e and returns the result of the command it runs. If e
+// ends in a condition rather than Run, this is the count the test reports and
+// takes a single request. Otherwise the result is captured with store result
+// in a key of the command storage rcon:execute that is unique to the call,
+// read back and then removed, which takes up to 3 requests. The key is
+// removed even if the command fails. If nothing ran, it returns
+// ErrNothingChanged.
+//
+// Like the rest of MinecraftClient, ExecuteResult is not safe to call
+// concurrently.
+func (mc *MinecraftClient) ExecuteResult(e *Execute) (int, error) {
+	if !e.run {
+		return mc.ExecuteTest(e)
+	}
+	line, err := e.CommandArgument()
+	if err != nil {
+		return 0, err
+	}
+	key, err := newExecuteKey()
+	if err != nil {
+		return 0, err
+	}
+	store := NewExecute().StoreResult(
+		StoreStorage(executeStorage, key, StoreInt, 1))
+	resp, err := mc.Execute(store.Run(client.NewCommand("execute").
+		Arg(rawArgument(strings.TrimPrefix(line, "execute ")))))
+	if err != nil {
+		return 0, err
+	}
+	// Best effort, the key is not stored if the command failed.
+	defer mc.request(client.NewCommand("data").Literal("remove").
+		Literal("storage").Literal(executeStorage).Literal(key))
+	var respErr *ResponseError
+	if errors.As(newResponseError(resp), &respErr) && respErr.Err != nil {
+		return 0, respErr
+	}
+	tag, err := mc.DataGetStorage(executeStorage, key)
+	if err != nil {
+		if ignoreResponseError(err) == nil {
+			return 0, ErrNothingChanged
+		}
+		return 0, err
+	}
+	value, ok := nbt.Number(tag)
+	if !ok || value != math.Trunc(value) {
+		return 0, fmt.Errorf("invalid result: %s", tag)
+	}
+	return int(value), nil
+}
+
+// newExecuteKey returns a random storage key for ExecuteResult.
+func newExecuteKey() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "result_" + hex.EncodeToString(buf), nil
+}
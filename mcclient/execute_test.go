@@ -0,0 +1,295 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+	"github.com/golang/mock/gomock"
+)
+
+func TestExecuteRender(t *testing.T) {
+	type testCase struct {
+		name     string
+		execute  *Execute
+		expected string
+	}
+	testCases := []testCase{
+		{"AsAt",
+			NewExecute().As(NewSelector(SelectorAllPlayers)).
+				At(NewSelector(SelectorSelf)).
+				Run(client.NewCommand("say").Text("hi")),
+			"execute as @a at @s run say hi"},
+		{"Position",
+			NewExecute().In("minecraft:the_nether").Positioned(Pos(0, 64, 0)).
+				Rotated(Rotation{Abs(90), Rel(0)}).Align("xz").
+				Anchored(AnchorEyes).
+				Run(client.NewCommand("setblock").Arg(Vec3{Loc(0), Loc(0),
+					Loc(1)}).Literal("minecraft:stone")),
+			"execute in minecraft:the_nether positioned 0 64 0 rotated 90 ~ " +
+				"align xz anchored eyes run setblock ^ ^ ^1 minecraft:stone"},
+		{"As",
+			NewExecute().PositionedAs(Player("test")).RotatedAs(Player("test")).
+				Facing(Pos(0, 64, 0)).
+				FacingEntity(NewSelector(SelectorNearestPlayer), AnchorFeet),
+			"execute positioned as test rotated as test facing 0 64 0 " +
+				"facing entity @p feet"},
+		{"Conditions",
+			NewExecute().
+				If(BlockCondition(Pos(1, 2, 3), BlockState{ID: "minecraft:air"})).
+				Unless(EntityCondition(NewSelector(SelectorAllEntities).
+					Type("minecraft:creeper"))).
+				If(ScoreCondition(Player("test"), "kills", ScoreGreater,
+					ScoreHolder("#best"), "kills")).
+				If(ScoreMatchesCondition(Player("test"), "deaths",
+					Between(1, 5))).
+				Unless(DataEntityCondition(Player("test"),
+					"Inventory[0].id")).
+				If(DataBlockCondition(Pos(0, 64, 0), "Items")).
+				If(DataStorageCondition("example:state", "started")).
+				If(PredicateCondition("example:is_night")),
+			"execute if block 1 2 3 minecraft:air " +
+				"unless entity @e[type=minecraft:creeper] " +
+				"if score test kills > #best kills " +
+				"if score test deaths matches 1..5 " +
+				"unless data entity test Inventory[0].id " +
+				"if data block 0 64 0 Items " +
+				"if data storage example:state started " +
+				"if predicate example:is_night"},
+		{"Store",
+			NewExecute().
+				StoreResult(StoreScore(ScoreHolder("#count"), "stats")).
+				StoreSuccess(StoreBossbar("example:timer", BossbarValue)).
+				StoreResult(StoreStorage("example:state", "players.count",
+					StoreInt, 1)).
+				StoreResult(StoreEntity(Player("test"), "Health", StoreFloat,
+					0.5)).
+				StoreResult(StoreBlock(Pos(0, 64, 0), "Items[0].Count",
+					StoreByte, 1)).
+				Run(client.NewCommand("list")),
+			"execute store result score #count stats " +
+				"store success bossbar example:timer value " +
+				"store result storage example:state players.count int 1 " +
+				"store result entity test Health float 0.5 " +
+				"store result block 0 64 0 Items[0].Count byte 1 run list"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			str, err := tcase.execute.CommandArgument()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+		})
+	}
+}
+
+func TestExecuteRenderFailures(t *testing.T) {
+	type testCase struct {
+		name     string
+		execute  *Execute
+		expected string
+	}
+	testCases := []testCase{
+		{"Align", NewExecute().Align("xx"), `invalid axes: "xx"`},
+		{"Anchor", NewExecute().Anchored(Anchor("head")),
+			"invalid anchor: head"},
+		{"Comparison", NewExecute().If(ScoreCondition(Player("test"), "a",
+			ScoreComparison("!="), Player("test"), "b")),
+			"invalid score comparison: !="},
+		{"Range", NewExecute().If(ScoreMatchesCondition(Player("test"), "a",
+			AtLeast(1.5))), "invalid integer range: 1.5.."},
+		{"Path", NewExecute().If(DataStorageCondition("example:state",
+			"a..b")), "expected key"},
+		{"StoreType", NewExecute().StoreResult(StoreStorage("example:state",
+			"a", StoreType("string"), 1)), "invalid store type: string"},
+		{"BossbarField", NewExecute().StoreResult(StoreBossbar("example:timer",
+			BossbarField("name"))), "invalid bossbar field: name"},
+		{"MissingCondition", NewExecute().If(Condition{}), "missing condition"},
+		{"MissingStore", NewExecute().StoreResult(StoreTarget{}),
+			"missing store target"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := tcase.execute.CommandArgument()
+			expectError(t, err, tcase.expected)
+		})
+	}
+}
+
+func TestExecuteTest(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	type testCase struct {
+		response string
+		count    int
+	}
+	testCases := []testCase{
+		{"Test passed, count: 4", 4},
+		{"Test passed", 1},
+		{"Test failed", 0},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.response, func(t *testing.T) {
+			tc.client.EXPECT().Request("execute if entity @a[team=red]").
+				Return(tcase.response, nil)
+			count, err := tc.mc.ExecuteTest(NewExecute().If(EntityCondition(
+				NewSelector(SelectorAllPlayers).Team("red"))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tcase.count {
+				t.Errorf("Expected: %d Got: %d", tcase.count, count)
+			}
+		})
+	}
+}
+
+// expectStore expects an execute store result command for ExecuteResult
+// that ends with suffix and returns resp, recording the storage key it uses.
+func (tc *testContext) expectStore(t *testing.T, key *string, suffix,
+	resp string) {
+	prefix := "execute store result storage rcon:execute "
+	tc.client.EXPECT().Request(gomock.Any()).
+		DoAndReturn(func(cmd string) (string, error) {
+			fields := strings.Fields(strings.TrimPrefix(cmd, prefix))
+			if !strings.HasPrefix(cmd, prefix) || len(fields) == 0 ||
+				!strings.HasSuffix(cmd, suffix) {
+				t.Errorf("Unexpected command: %s", cmd)
+				return "", nil
+			}
+			*key = fields[0]
+			return resp, nil
+		})
+}
+
+// expectRemove expects ExecuteResult to remove the storage key it used and
+// returns resp.
+func (tc *testContext) expectRemove(t *testing.T, key *string, resp string) {
+	tc.client.EXPECT().Request(gomock.Any()).
+		DoAndReturn(func(cmd string) (string, error) {
+			if cmd != "data remove storage rcon:execute "+*key {
+				t.Errorf("Unexpected command: %s", cmd)
+			}
+			return resp, nil
+		})
+}
+
+func TestExecuteResult(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	var key string
+	tc.expectStore(t, &key, " int 1 run execute in minecraft:the_end run "+
+		"scoreboard players get #timer stats", "#timer has 42 [stats]")
+	tc.client.EXPECT().Request(gomock.Any()).
+		DoAndReturn(func(cmd string) (string, error) {
+			if cmd != "data get storage rcon:execute "+key {
+				t.Errorf("Unexpected command: %s", cmd)
+			}
+			return "Storage rcon:execute has the following contents: 42", nil
+		})
+	tc.expectRemove(t, &key, "Modified storage rcon:execute")
+	result, err := tc.mc.ExecuteResult(NewExecute().In("minecraft:the_end").
+		Run(newPlayersCommand("get").Arg(ScoreHolder("#timer")).
+			Literal("stats")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != 42 {
+		t.Errorf("Expected: 42 Got: %d", result)
+	}
+	if !strings.HasPrefix(key, "result_") {
+		t.Errorf("Expected a unique result key, got: %s", key)
+	}
+
+	var other string
+	tc.expectStore(t, &other, " run execute run list", "")
+	tc.client.EXPECT().Request(gomock.Any()).
+		Return("Storage rcon:execute has the following contents: 3", nil)
+	tc.client.EXPECT().Request(gomock.Any()).
+		Return("Modified storage rcon:execute", nil)
+	if _, err := tc.mc.ExecuteResult(NewExecute().
+		Run(client.NewCommand("list"))); err != nil {
+		t.Fatal(err)
+	}
+	if other == key {
+		t.Errorf("Expected a different key for each call: %s", key)
+	}
+}
+
+func TestExecuteResultTest(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("execute as @a if entity @s[team=red]").
+		Return("Test passed, count: 2", nil)
+	result, err := tc.mc.ExecuteResult(NewExecute().
+		As(NewSelector(SelectorAllPlayers)).
+		If(EntityCondition(NewSelector(SelectorSelf).Team("red"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != 2 {
+		t.Errorf("Expected: 2 Got: %d", result)
+	}
+}
+
+func TestExecuteResultFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	var key string
+	tc.expectStore(t, &key, " int 1 run execute as @e[type=minecraft:pig] run "+
+		"kill @s", "")
+	tc.client.EXPECT().Request(gomock.Any()).
+		Return("Found no elements matching result", nil)
+	tc.expectRemove(t, &key, "Nothing changed. The specified properties "+
+		"already have these values")
+	_, err := tc.mc.ExecuteResult(NewExecute().
+		As(NewSelector(SelectorAllEntities).Type("minecraft:pig")).
+		Run(client.NewCommand("kill").Arg(NewSelector(SelectorSelf))))
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+
+	tc.expectStore(t, &key, " int 1 run execute run fly",
+		"Unknown or incomplete command, see below for error")
+	tc.expectRemove(t, &key, "Nothing changed. The specified properties "+
+		"already have these values")
+	_, err = tc.mc.ExecuteResult(NewExecute().Run(client.NewCommand("fly")))
+	if !errors.Is(err, ErrUnknownCommand) {
+		t.Errorf("Expected: %v Got: %v", ErrUnknownCommand, err)
+	}
+}
+
+func TestExecuteStoreStorage(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("execute store result storage example:stats "+
+		"online int 1 run list").
+		Return("There are 3 of a max of 20 players online: a, b, c", nil)
+	tc.client.EXPECT().Request("data get storage example:stats").
+		Return("Storage example:stats has the following contents: "+
+			"{online:3}", nil)
+	_, err := tc.mc.Execute(NewExecute().
+		StoreResult(StoreStorage("example:stats", "online", StoreInt, 1)).
+		Run(client.NewCommand("list")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, err := tc.mc.DataGetStorage("example:stats", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if online, ok := nbt.Number(tag.(nbt.Compound)["online"]); !ok ||
+		online != 3 {
+		t.Errorf("Expected: 3 Got: %v", tag)
+	}
+}
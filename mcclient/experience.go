@@ -0,0 +1,75 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	xpQueryRegex *regexp.Regexp = regexp.MustCompile(
+		`^\S+ has (-?\d+) experience (?:points|levels)`)
+)
+
+// ExperienceUnit is whether an experience amount is in points or levels.
+type ExperienceUnit string
+
+const (
+	// ExperiencePoints counts experience points.
+	ExperiencePoints ExperienceUnit = "points"
+	// ExperienceLevels counts experience levels.
+	ExperienceLevels ExperienceUnit = "levels"
+)
+
+func (unit ExperienceUnit) validate() error {
+	switch unit {
+	case ExperiencePoints, ExperienceLevels:
+		return nil
+	}
+	return fmt.Errorf("invalid experience unit: %s", unit)
+}
+
+func newXPCommand(subcommand string, target Target) *client.Command {
+	return client.NewCommand("xp").Literal(subcommand).Arg(target)
+}
+
+// XPAdd gives amount of experience to the targeted players. A negative amount
+// takes experience away.
+func (mc *MinecraftClient) XPAdd(target Target, amount int,
+	unit ExperienceUnit) error {
+	if err := unit.validate(); err != nil {
+		return err
+	}
+	return mc.simpleRequest(newXPCommand("add", target).Int(amount).
+		Literal(string(unit)), "Gave")
+}
+
+// XPSet sets the experience of the targeted players. Points can not be set
+// beyond what the player's current level holds.
+func (mc *MinecraftClient) XPSet(target Target, amount int,
+	unit ExperienceUnit) error {
+	if err := unit.validate(); err != nil {
+		return err
+	}
+	if amount < 0 {
+		return fmt.Errorf("invalid experience amount: %d", amount)
+	}
+	return mc.simpleRequest(newXPCommand("set", target).Int(amount).
+		Literal(string(unit)), "Set")
+}
+
+// XPQuery returns the experience points towards the next level, or the
+// experience level, of a single player.
+func (mc *MinecraftClient) XPQuery(target Target,
+	unit ExperienceUnit) (int, error) {
+	if err := unit.validate(); err != nil {
+		return 0, err
+	}
+	resp, err := mc.request(newXPCommand("query", target).
+		Literal(string(unit)))
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, xpQueryRegex)
+}
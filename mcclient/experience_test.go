@@ -0,0 +1,65 @@
+package client
+
+import "testing"
+
+func TestXPAddSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("xp add test 5 levels").
+		Return("Gave 5 experience levels to test", nil)
+	if err := tc.mc.XPAdd(Player("test"), 5, ExperienceLevels); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("xp add @a -10 points").
+		Return("Gave -10 experience points to 2 players", nil)
+	err := tc.mc.XPAdd(NewSelector(SelectorAllPlayers), -10, ExperiencePoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("xp set test 30 levels").
+		Return("Set 30 experience levels on test", nil)
+	if err := tc.mc.XPSet(Player("test"), 30, ExperienceLevels); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestXPFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.XPAdd(Player("test"), 5, ExperienceUnit("orbs"))
+	expectError(t, err, "invalid experience unit: orbs")
+	err = tc.mc.XPSet(Player("test"), -1, ExperienceLevels)
+	expectError(t, err, "invalid experience amount: -1")
+
+	tc.client.EXPECT().Request("xp set test 5000 points").
+		Return("Cannot set experience points above the maximum points for "+
+			"the player's current level", nil)
+	err = tc.mc.XPSet(Player("test"), 5000, ExperiencePoints)
+	expectError(t, err, "Cannot set experience points")
+}
+
+func TestXPQuerySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("xp query test levels").
+		Return("test has 12 experience levels", nil)
+	levels, err := tc.mc.XPQuery(Player("test"), ExperienceLevels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if levels != 12 {
+		t.Errorf("Expected: 12 Got: %d", levels)
+	}
+	tc.client.EXPECT().Request("xp query test points").
+		Return("test has 7 experience points", nil)
+	points, err := tc.mc.XPQuery(Player("test"), ExperiencePoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if points != 7 {
+		t.Errorf("Expected: 7 Got: %d", points)
+	}
+}
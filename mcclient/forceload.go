@@ -0,0 +1,157 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	forceloadCountRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:Marked|Unmarked) (\d+) chunks`)
+	forceloadListRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:A force loaded chunk was|\d+ force loaded chunks were) found ` +
+			`in \S+ at: (.*)$`)
+	forceloadChunkRegex *regexp.Regexp = regexp.MustCompile(
+		`^Chunk at \[-?\d+, -?\d+\] in \S+ is (not )?marked for force loading`)
+	chunkPosRegex *regexp.Regexp = regexp.MustCompile(`\[(-?\d+), (-?\d+)\]`)
+)
+
+// Dimensions are the dimensions of a vanilla server.
+var Dimensions = []string{
+	"minecraft:overworld",
+	"minecraft:the_nether",
+	"minecraft:the_end",
+}
+
+// ChunkPos is the position of a chunk, in chunks rather than blocks.
+type ChunkPos struct {
+	X, Z int
+}
+
+// CommandArgument formats the position of the chunk's first block column.
+func (chunk ChunkPos) CommandArgument() (string, error) {
+	return strconv.Itoa(chunk.X*16) + " " + strconv.Itoa(chunk.Z*16), nil
+}
+
+func newForceloadCommand(subcommand string) *client.Command {
+	return client.NewCommand("forceload").Literal(subcommand)
+}
+
+// inDimension runs cmd in dimension, or in the overworld if dimension is
+// empty.
+func (mc *MinecraftClient) inDimension(dimension string,
+	cmd *client.Command) (string, error) {
+	if dimension == "" {
+		return mc.request(cmd)
+	}
+	return mc.Execute(NewExecute().In(dimension).Run(cmd))
+}
+
+// forceloadChange runs add or remove on the chunks from one corner to the
+// other and returns how many chunks changed.
+func (mc *MinecraftClient) forceloadChange(subcommand, prefix,
+	dimension string, from, to ChunkPos) (int, error) {
+	resp, err := mc.inDimension(dimension, newForceloadCommand(subcommand).
+		Arg(from).Arg(to))
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasPrefix(resp, prefix) {
+		return 0, newResponseError(resp)
+	}
+	if forceloadCountRegex.MatchString(resp) {
+		return parseResponseInt(resp, forceloadCountRegex)
+	}
+	return 1, nil
+}
+
+// ForceloadAdd keeps the chunks from one corner to the other loaded in
+// dimension and returns how many chunks were newly marked. An empty
+// dimension is the overworld.
+func (mc *MinecraftClient) ForceloadAdd(dimension string, from,
+	to ChunkPos) (int, error) {
+	return mc.forceloadChange("add", "Marked", dimension, from, to)
+}
+
+// ForceloadRemove stops keeping the chunks from one corner to the other
+// loaded in dimension and returns how many chunks were unmarked.
+func (mc *MinecraftClient) ForceloadRemove(dimension string, from,
+	to ChunkPos) (int, error) {
+	return mc.forceloadChange("remove", "Unmarked", dimension, from, to)
+}
+
+// ForceloadRemoveAll stops keeping any chunks loaded in dimension.
+func (mc *MinecraftClient) ForceloadRemoveAll(dimension string) error {
+	resp, err := mc.inDimension(dimension, newForceloadCommand("remove").
+		Literal("all"))
+	if err != nil {
+		return err
+	}
+	return validateResponsePrefix(resp, "Unmarked all force loaded chunks")
+}
+
+// ForceloadQuery returns the chunks kept loaded in dimension.
+func (mc *MinecraftClient) ForceloadQuery(dimension string) ([]ChunkPos,
+	error) {
+	resp, err := mc.inDimension(dimension, newForceloadCommand("query"))
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(resp, "No force loaded chunks were found") {
+		return []ChunkPos{}, nil
+	}
+	matches, err := parseResponse(resp, forceloadListRegex)
+	if err != nil {
+		return nil, err
+	}
+	chunks := []ChunkPos{}
+	for _, match := range chunkPosRegex.FindAllStringSubmatch(matches[1], -1) {
+		x, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, err
+		}
+		z, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, ChunkPos{X: x, Z: z})
+	}
+	return chunks, nil
+}
+
+// ForceloadQueryAll returns the chunks kept loaded in each of dimensions,
+// or in each of the vanilla Dimensions if none are given.
+func (mc *MinecraftClient) ForceloadQueryAll(
+	dimensions ...string) (map[string][]ChunkPos, error) {
+	if len(dimensions) == 0 {
+		dimensions = Dimensions
+	}
+	all := make(map[string][]ChunkPos, len(dimensions))
+	for _, dimension := range dimensions {
+		chunks, err := mc.ForceloadQuery(dimension)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", dimension, err)
+		}
+		all[dimension] = chunks
+	}
+	return all, nil
+}
+
+// ForceloadQueryChunk returns whether chunk is kept loaded in dimension.
+func (mc *MinecraftClient) ForceloadQueryChunk(dimension string,
+	chunk ChunkPos) (bool, error) {
+	resp, err := mc.inDimension(dimension, newForceloadCommand("query").
+		Arg(chunk))
+	if err != nil {
+		return false, err
+	}
+	matches, err := parseResponse(resp, forceloadChunkRegex)
+	if err != nil {
+		return false, err
+	}
+	return matches[1] == "", nil
+}
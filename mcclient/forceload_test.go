@@ -0,0 +1,153 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestForceloadAddRemoveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("forceload add 0 0 16 -16").
+		Return("Marked 4 chunks in minecraft:overworld from [0, -1] to [1, 0] "+
+			"to be force loaded", nil)
+	count, err := tc.mc.ForceloadAdd("", ChunkPos{0, 0}, ChunkPos{1, -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Errorf("Expected: 4 Got: %d", count)
+	}
+	tc.client.EXPECT().Request(
+		"execute in minecraft:the_nether run forceload remove -32 48 -32 48").
+		Return("Unmarked chunk [-2, 3] in minecraft:the_nether for force "+
+			"loading", nil)
+	count, err = tc.mc.ForceloadRemove("minecraft:the_nether",
+		ChunkPos{-2, 3}, ChunkPos{-2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("Expected: 1 Got: %d", count)
+	}
+	tc.client.EXPECT().Request(
+		"execute in minecraft:the_end run forceload remove all").
+		Return("Unmarked all force loaded chunks in minecraft:the_end", nil)
+	if err := tc.mc.ForceloadRemoveAll("minecraft:the_end"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestForceloadAddFailures(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("forceload add 0 0 0 0").
+		Return("No chunks were marked for force loading", nil)
+	_, err := tc.mc.ForceloadAdd("", ChunkPos{}, ChunkPos{})
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected: %v Got: %v", ErrNothingChanged, err)
+	}
+	tc.client.EXPECT().Request("forceload add 0 0 320 320").
+		Return("Too many chunks in the specified area (maximum 256, "+
+			"specified 441)", nil)
+	_, err = tc.mc.ForceloadAdd("", ChunkPos{}, ChunkPos{20, 20})
+	if !errors.Is(err, ErrTooManyChunks) {
+		t.Errorf("Expected: %v Got: %v", ErrTooManyChunks, err)
+	}
+}
+
+func TestForceloadQuery(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	type testCase struct {
+		response string
+		chunks   []ChunkPos
+	}
+	testCases := []testCase{
+		{"3 force loaded chunks were found in minecraft:overworld at: " +
+			"[0, 0], [-1, 12], [5, -3]",
+			[]ChunkPos{{0, 0}, {-1, 12}, {5, -3}}},
+		{"A force loaded chunk was found in minecraft:overworld at: [7, 8]",
+			[]ChunkPos{{7, 8}}},
+		{"No force loaded chunks were found in minecraft:overworld",
+			[]ChunkPos{}},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.response, func(t *testing.T) {
+			tc.client.EXPECT().Request("forceload query").
+				Return(tcase.response, nil)
+			chunks, err := tc.mc.ForceloadQuery("")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(chunks, tcase.chunks) {
+				t.Errorf("Expected: %v Got: %v", tcase.chunks, chunks)
+			}
+		})
+	}
+}
+
+func TestForceloadQueryAll(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		"execute in minecraft:overworld run forceload query").
+		Return("A force loaded chunk was found in minecraft:overworld at: "+
+			"[1, 2]", nil)
+	tc.client.EXPECT().Request(
+		"execute in minecraft:the_nether run forceload query").
+		Return("No force loaded chunks were found in minecraft:the_nether", nil)
+	tc.client.EXPECT().Request(
+		"execute in minecraft:the_end run forceload query").
+		Return("2 force loaded chunks were found in minecraft:the_end at: "+
+			"[0, 0], [0, 1]", nil)
+	all, err := tc.mc.ForceloadQueryAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string][]ChunkPos{
+		"minecraft:overworld":  {{1, 2}},
+		"minecraft:the_nether": {},
+		"minecraft:the_end":    {{0, 0}, {0, 1}},
+	}
+	if !reflect.DeepEqual(all, expected) {
+		t.Errorf("Expected: %v Got: %v", expected, all)
+	}
+
+	tc.client.EXPECT().Request(
+		"execute in example:mining run forceload query").
+		Return("Unknown dimension 'example:mining'", nil)
+	_, err = tc.mc.ForceloadQueryAll("example:mining")
+	expectError(t, err, "example:mining: Unknown dimension")
+}
+
+func TestForceloadQueryChunk(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("forceload query 16 32").
+		Return("Chunk at [1, 2] in minecraft:overworld is marked for force "+
+			"loading", nil)
+	loaded, err := tc.mc.ForceloadQueryChunk("", ChunkPos{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded {
+		t.Errorf("Expected chunk to be force loaded")
+	}
+	tc.client.EXPECT().Request("forceload query 16 48").
+		Return("Chunk at [1, 3] in minecraft:overworld is not marked for "+
+			"force loading", nil)
+	loaded, err = tc.mc.ForceloadQueryChunk("", ChunkPos{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded {
+		t.Errorf("Expected chunk to not be force loaded")
+	}
+}
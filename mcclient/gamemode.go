@@ -0,0 +1,129 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	difficultyGetRegex *regexp.Regexp = regexp.MustCompile(
+		`^The difficulty is (\w+)`)
+	difficultySetRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:The difficulty has been set to|` +
+			`The difficulty did not change; it is already set to) (\w+)`)
+)
+
+// Difficulty is the difficulty of the world.
+type Difficulty string
+
+const (
+	// DifficultyPeaceful disables hostile mobs.
+	DifficultyPeaceful Difficulty = "peaceful"
+	// DifficultyEasy is the easiest difficulty with hostile mobs.
+	DifficultyEasy Difficulty = "easy"
+	// DifficultyNormal is the default difficulty.
+	DifficultyNormal Difficulty = "normal"
+	// DifficultyHard is the hardest difficulty.
+	DifficultyHard Difficulty = "hard"
+)
+
+// ParseDifficulty parses a difficulty name, ignoring case.
+func ParseDifficulty(name string) (Difficulty, error) {
+	difficulty := Difficulty(strings.ToLower(name))
+	switch difficulty {
+	case DifficultyPeaceful, DifficultyEasy, DifficultyNormal, DifficultyHard:
+		return difficulty, nil
+	}
+	return "", fmt.Errorf("invalid difficulty: %s", name)
+}
+
+// GameMode is the game mode of a player.
+type GameMode string
+
+const (
+	// GameModeSurvival is the default game mode.
+	GameModeSurvival GameMode = "survival"
+	// GameModeCreative allows flying and unlimited blocks.
+	GameModeCreative GameMode = "creative"
+	// GameModeAdventure prevents breaking and placing blocks.
+	GameModeAdventure GameMode = "adventure"
+	// GameModeSpectator allows flying through blocks without interacting.
+	GameModeSpectator GameMode = "spectator"
+)
+
+// ParseGameMode parses a game mode name, such as "Creative Mode" or
+// "creative", ignoring case.
+func ParseGameMode(name string) (GameMode, error) {
+	mode := GameMode(strings.ToLower(strings.TrimSuffix(name, " Mode")))
+	if err := mode.validate(); err != nil {
+		return "", fmt.Errorf("invalid game mode: %s", name)
+	}
+	return mode, nil
+}
+
+func (mode GameMode) validate() error {
+	switch mode {
+	case GameModeSurvival, GameModeCreative, GameModeAdventure,
+		GameModeSpectator:
+		return nil
+	}
+	return fmt.Errorf("invalid game mode: %s", mode)
+}
+
+// DifficultyGet returns the current difficulty.
+func (mc *MinecraftClient) DifficultyGet() (Difficulty, error) {
+	resp, err := mc.request(client.NewCommand("difficulty"))
+	if err != nil {
+		return "", err
+	}
+	matches, err := parseResponse(resp, difficultyGetRegex)
+	if err != nil {
+		return "", err
+	}
+	return ParseDifficulty(matches[1])
+}
+
+// DifficultySet sets the difficulty. Setting the difficulty it already has is
+// not an error.
+func (mc *MinecraftClient) DifficultySet(difficulty Difficulty) error {
+	if _, err := ParseDifficulty(string(difficulty)); err != nil {
+		return err
+	}
+	resp, err := mc.request(client.NewCommand("difficulty").
+		Literal(string(difficulty)))
+	if err != nil {
+		return err
+	}
+	_, err = parseResponse(resp, difficultySetRegex)
+	return err
+}
+
+// GameModeSet sets the game mode of the targeted players. Players that are
+// already in mode are left alone.
+func (mc *MinecraftClient) GameModeSet(target Target, mode GameMode) error {
+	if err := mode.validate(); err != nil {
+		return err
+	}
+	resp, err := mc.request(client.NewCommand("gamemode").
+		Literal(string(mode)).Arg(target))
+	if err != nil {
+		return err
+	}
+	// Nothing is returned when every player already had the game mode.
+	if len(resp) == 0 {
+		return nil
+	}
+	return validateResponsePrefix(resp, "Set ")
+}
+
+// DefaultGameModeSet sets the game mode new players join with.
+func (mc *MinecraftClient) DefaultGameModeSet(mode GameMode) error {
+	if err := mode.validate(); err != nil {
+		return err
+	}
+	return mc.simpleRequest(client.NewCommand("defaultgamemode").
+		Literal(string(mode)), "The default game mode is now")
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestDifficultyGetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("difficulty").
+		Return("The difficulty is Normal", nil)
+	difficulty, err := tc.mc.DifficultyGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if difficulty != DifficultyNormal {
+		t.Errorf("Expected: %s Got: %s", DifficultyNormal, difficulty)
+	}
+}
+
+func TestDifficultySetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("difficulty hard").
+		Return("The difficulty has been set to Hard", nil)
+	if err := tc.mc.DifficultySet(DifficultyHard); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("difficulty hard").
+		Return("The difficulty did not change; it is already set to Hard", nil)
+	if err := tc.mc.DifficultySet(DifficultyHard); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDifficultySetInvalid(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.DifficultySet("nightmare")
+	expectError(t, err, "invalid difficulty: nightmare")
+}
+
+func TestGameModeSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("gamemode creative test").
+		Return("Set test's game mode to Creative Mode", nil)
+	if err := tc.mc.GameModeSet(Player("test"), GameModeCreative); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("gamemode survival @a[team=red]").
+		Return("", nil)
+	err := tc.mc.GameModeSet(NewSelector(SelectorAllPlayers).Team("red"),
+		GameModeSurvival)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGameModeSetErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("gamemode creative test").
+		Return("No player was found", nil)
+	err := tc.mc.GameModeSet(Player("test"), GameModeCreative)
+	expectError(t, err, "No player was found")
+	err = tc.mc.GameModeSet(Player("test"), "hardcore")
+	expectError(t, err, "invalid game mode: hardcore")
+}
+
+func TestDefaultGameModeSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("defaultgamemode adventure").
+		Return("The default game mode is now Adventure Mode", nil)
+	if err := tc.mc.DefaultGameModeSet(GameModeAdventure); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseGameMode(t *testing.T) {
+	for _, name := range []string{"Creative Mode", "creative", "CREATIVE"} {
+		mode, err := ParseGameMode(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode != GameModeCreative {
+			t.Errorf("Expected: %s Got: %s", GameModeCreative, mode)
+		}
+	}
+	_, err := ParseGameMode("Hardcore Mode")
+	expectError(t, err, "invalid game mode: Hardcore Mode")
+}
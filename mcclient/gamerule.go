@@ -0,0 +1,203 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	gameRuleGetRegex *regexp.Regexp = regexp.MustCompile(
+		`^Gamerule (\w+) is currently set to: (\S+)`)
+	gameRuleSetRegex *regexp.Regexp = regexp.MustCompile(
+		`^Gamerule (\w+) is now set to: (\S+)`)
+)
+
+// GameRuleType is the type of value a game rule holds.
+type GameRuleType int
+
+const (
+	// GameRuleBool rules are either true or false.
+	GameRuleBool GameRuleType = iota
+	// GameRuleInt rules hold an integer.
+	GameRuleInt
+)
+
+// String returns the name of the type.
+func (ruleType GameRuleType) String() string {
+	switch ruleType {
+	case GameRuleBool:
+		return "bool"
+	case GameRuleInt:
+		return "int"
+	}
+	return fmt.Sprintf("GameRuleType(%d)", int(ruleType))
+}
+
+// GameRules is the catalog of known game rules and their types. Servers may
+// not support every rule, and may support rules not listed here.
+var GameRules = map[string]GameRuleType{
+	"announceAdvancements":          GameRuleBool,
+	"blockExplosionDropDecay":       GameRuleBool,
+	"commandBlockOutput":            GameRuleBool,
+	"commandModificationBlockLimit": GameRuleInt,
+	"disableElytraMovementCheck":    GameRuleBool,
+	"disableRaids":                  GameRuleBool,
+	"doDaylightCycle":               GameRuleBool,
+	"doEntityDrops":                 GameRuleBool,
+	"doFireTick":                    GameRuleBool,
+	"doImmediateRespawn":            GameRuleBool,
+	"doInsomnia":                    GameRuleBool,
+	"doLimitedCrafting":             GameRuleBool,
+	"doMobLoot":                     GameRuleBool,
+	"doMobSpawning":                 GameRuleBool,
+	"doPatrolSpawning":              GameRuleBool,
+	"doTileDrops":                   GameRuleBool,
+	"doTraderSpawning":              GameRuleBool,
+	"doVinesSpread":                 GameRuleBool,
+	"doWardenSpawning":              GameRuleBool,
+	"doWeatherCycle":                GameRuleBool,
+	"drowningDamage":                GameRuleBool,
+	"fallDamage":                    GameRuleBool,
+	"fireDamage":                    GameRuleBool,
+	"forgiveDeadPlayers":            GameRuleBool,
+	"freezeDamage":                  GameRuleBool,
+	"globalSoundEvents":             GameRuleBool,
+	"keepInventory":                 GameRuleBool,
+	"lavaSourceConversion":          GameRuleBool,
+	"logAdminCommands":              GameRuleBool,
+	"maxCommandChainLength":         GameRuleInt,
+	"maxEntityCramming":             GameRuleInt,
+	"mobExplosionDropDecay":         GameRuleBool,
+	"mobGriefing":                   GameRuleBool,
+	"naturalRegeneration":           GameRuleBool,
+	"playersSleepingPercentage":     GameRuleInt,
+	"randomTickSpeed":               GameRuleInt,
+	"reducedDebugInfo":              GameRuleBool,
+	"sendCommandFeedback":           GameRuleBool,
+	"showDeathMessages":             GameRuleBool,
+	"snowAccumulationHeight":        GameRuleInt,
+	"spawnRadius":                   GameRuleInt,
+	"spectatorsGenerateChunks":      GameRuleBool,
+	"tntExplosionDropDecay":         GameRuleBool,
+	"universalAnger":                GameRuleBool,
+	"waterSourceConversion":         GameRuleBool,
+}
+
+func validateGameRuleType(rule string, ruleType GameRuleType) error {
+	known, ok := GameRules[rule]
+	if ok && known != ruleType {
+		return fmt.Errorf("game rule %s is %s not %s", rule, known, ruleType)
+	}
+	return nil
+}
+
+// GameRuleGet returns the current value of rule as a string.
+func (mc *MinecraftClient) GameRuleGet(rule string) (string, error) {
+	resp, err := mc.request(client.NewCommand("gamerule").Literal(rule))
+	if err != nil {
+		return "", err
+	}
+	matches, err := parseResponse(resp, gameRuleGetRegex)
+	if err != nil {
+		return "", err
+	}
+	return matches[2], nil
+}
+
+// GameRuleGetBool returns the current value of a boolean rule.
+func (mc *MinecraftClient) GameRuleGetBool(rule string) (bool, error) {
+	if err := validateGameRuleType(rule, GameRuleBool); err != nil {
+		return false, err
+	}
+	value, err := mc.GameRuleGet(rule)
+	if err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(value)
+}
+
+// GameRuleGetInt returns the current value of an integer rule.
+func (mc *MinecraftClient) GameRuleGetInt(rule string) (int, error) {
+	if err := validateGameRuleType(rule, GameRuleInt); err != nil {
+		return 0, err
+	}
+	value, err := mc.GameRuleGet(rule)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(value)
+}
+
+func (mc *MinecraftClient) gameRuleSet(cmd *client.Command) error {
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = parseResponse(resp, gameRuleSetRegex)
+	return err
+}
+
+// GameRuleSetBool sets a boolean rule.
+func (mc *MinecraftClient) GameRuleSetBool(rule string, value bool) error {
+	if err := validateGameRuleType(rule, GameRuleBool); err != nil {
+		return err
+	}
+	return mc.gameRuleSet(
+		client.NewCommand("gamerule").Literal(rule).Bool(value))
+}
+
+// GameRuleSetInt sets an integer rule.
+func (mc *MinecraftClient) GameRuleSetInt(rule string, value int) error {
+	if err := validateGameRuleType(rule, GameRuleInt); err != nil {
+		return err
+	}
+	return mc.gameRuleSet(
+		client.NewCommand("gamerule").Literal(rule).Int(value))
+}
+
+// GameRuleSnapshot reads every rule in GameRules into a map of rule name to
+// value. Rules the server does not support are left out.
+func (mc *MinecraftClient) GameRuleSnapshot() (map[string]string, error) {
+	rules := make([]string, 0, len(GameRules))
+	for rule := range GameRules {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	snapshot := make(map[string]string)
+	for _, rule := range rules {
+		value, err := mc.GameRuleGet(rule)
+		if errors.Is(err, ErrUnknownCommand) ||
+			errors.Is(err, ErrInvalidArgument) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		snapshot[rule] = value
+	}
+	return snapshot, nil
+}
+
+// GameRuleDiff returns the sorted names of rules whose values differ between
+// two snapshots, including rules that are only present in one of them.
+func GameRuleDiff(a, b map[string]string) []string {
+	diff := []string{}
+	for rule, value := range a {
+		other, ok := b[rule]
+		if !ok || other != value {
+			diff = append(diff, rule)
+		}
+	}
+	for rule := range b {
+		if _, ok := a[rule]; !ok {
+			diff = append(diff, rule)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
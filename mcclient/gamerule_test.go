@@ -0,0 +1,114 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestGameRuleGetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("gamerule keepInventory").
+		Return("Gamerule keepInventory is currently set to: true", nil)
+	keep, err := tc.mc.GameRuleGetBool("keepInventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !keep {
+		t.Error("Expected keepInventory to be true")
+	}
+
+	tc.client.EXPECT().Request("gamerule randomTickSpeed").
+		Return("Gamerule randomTickSpeed is currently set to: 3", nil)
+	speed, err := tc.mc.GameRuleGetInt("randomTickSpeed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if speed != 3 {
+		t.Errorf("Expected: 3 Got: %d", speed)
+	}
+}
+
+func TestGameRuleSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("gamerule doDaylightCycle false").
+		Return("Gamerule doDaylightCycle is now set to: false", nil)
+	if err := tc.mc.GameRuleSetBool("doDaylightCycle", false); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("gamerule randomTickSpeed 10").
+		Return("Gamerule randomTickSpeed is now set to: 10", nil)
+	if err := tc.mc.GameRuleSetInt("randomTickSpeed", 10); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGameRuleWrongType(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.GameRuleSetInt("keepInventory", 1)
+	expectError(t, err, "game rule keepInventory is bool not int")
+	_, err = tc.mc.GameRuleGetBool("randomTickSpeed")
+	expectError(t, err, "game rule randomTickSpeed is int not bool")
+}
+
+func TestGameRuleSetErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("gamerule fakeRule true").
+		Return("Incorrect argument for command", nil)
+	err := tc.mc.GameRuleSetBool("fakeRule", true)
+	expectError(t, err, "Incorrect argument")
+}
+
+func TestGameRuleSnapshot(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(gomock.Any()).AnyTimes().
+		DoAndReturn(func(cmd string) (string, error) {
+			switch cmd {
+			case "gamerule keepInventory":
+				return "Gamerule keepInventory is currently set to: true", nil
+			case "gamerule randomTickSpeed":
+				return "Gamerule randomTickSpeed is currently set to: 3", nil
+			}
+			return "Incorrect argument for command", nil
+		})
+	snapshot, err := tc.mc.GameRuleSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"keepInventory":   "true",
+		"randomTickSpeed": "3",
+	}
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("Got: %v Expected: %v", snapshot, expected)
+	}
+}
+
+func TestGameRuleDiff(t *testing.T) {
+	a := map[string]string{
+		"keepInventory":   "true",
+		"randomTickSpeed": "3",
+		"mobGriefing":     "true",
+	}
+	b := map[string]string{
+		"keepInventory":   "false",
+		"randomTickSpeed": "3",
+		"doFireTick":      "true",
+	}
+	diff := GameRuleDiff(a, b)
+	expected := []string{"doFireTick", "keepInventory", "mobGriefing"}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("Got: %v Expected: %v", diff, expected)
+	}
+}
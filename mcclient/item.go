@@ -0,0 +1,118 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+var (
+	resourceRegex *regexp.Regexp = regexp.MustCompile(
+		`^#?(?:[a-z0-9_\-\.]+:)?[a-z0-9_\-\./]+$`)
+	clearRegex *regexp.Regexp = regexp.MustCompile(
+		`^(?:Removed|Found) (\d+) (?:matching )?items?`)
+)
+
+func validateResource(id string) error {
+	if !resourceRegex.MatchString(id) {
+		return fmt.Errorf("invalid resource location: %s", id)
+	}
+	return nil
+}
+
+// ItemStack describes an item, such as minecraft:diamond_sword, along with
+// any data attached to it.
+type ItemStack struct {
+	ID string
+	// Components are data components, such as minecraft:custom_name, used on
+	// 1.20.5 and newer.
+	Components nbt.Compound
+	// NBT is the item's tag on versions before 1.20.5.
+	NBT nbt.Compound
+}
+
+// CommandArgument formats the item as id[components]{nbt}.
+func (item ItemStack) CommandArgument() (string, error) {
+	if err := validateResource(item.ID); err != nil {
+		return "", err
+	}
+	out := item.ID
+	if len(item.Components) > 0 {
+		parts := []string{}
+		for _, key := range item.Components.Keys() {
+			if err := validateResource(key); err != nil {
+				return "", err
+			}
+			parts = append(parts, key+"="+item.Components[key].String())
+		}
+		out += "[" + strings.Join(parts, ",") + "]"
+	}
+	if len(item.NBT) > 0 {
+		out += item.NBT.String()
+	}
+	return out, nil
+}
+
+// Give gives count of item to the targeted players.
+func (mc *MinecraftClient) Give(target Target, item ItemStack,
+	count int) error {
+	if count < 1 {
+		return fmt.Errorf("invalid count: %d", count)
+	}
+	return mc.simpleRequest(client.NewCommand("give").Arg(target).Arg(item).
+		Int(count), "Gave")
+}
+
+// Clear removes up to maxCount of item from the targeted players and returns
+// how many were removed. A nil item matches every item and a negative
+// maxCount removes every match. A maxCount of 0 removes nothing and only
+// counts the matching items.
+func (mc *MinecraftClient) Clear(target Target, item *ItemStack,
+	maxCount int) (int, error) {
+	cmd := client.NewCommand("clear").Arg(target)
+	if item != nil {
+		cmd.Arg(item)
+		if maxCount >= 0 {
+			cmd.Int(maxCount)
+		}
+	} else if maxCount >= 0 {
+		return 0, fmt.Errorf("maxCount requires an item")
+	}
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return 0, err
+	}
+	if strings.HasPrefix(resp, "No items were found") {
+		return 0, nil
+	}
+	return parseResponseInt(resp, clearRegex)
+}
+
+func newItemReplaceCommand(kind string) *client.Command {
+	return client.NewCommand("item").Literal("replace").Literal(kind)
+}
+
+// ItemReplace puts count of item into a slot, such as armor.head or
+// hotbar.0, of the targeted entities.
+func (mc *MinecraftClient) ItemReplace(target Target, slot string,
+	item ItemStack, count int) error {
+	if count < 1 {
+		return fmt.Errorf("invalid count: %d", count)
+	}
+	return mc.simpleRequest(newItemReplaceCommand("entity").Arg(target).
+		Literal(slot).Literal("with").Arg(item).Int(count), "Replaced")
+}
+
+// ItemReplaceBlock puts count of item into a slot, such as container.0, of
+// the block at pos.
+func (mc *MinecraftClient) ItemReplaceBlock(pos Vec3, slot string,
+	item ItemStack, count int) error {
+	if count < 1 {
+		return fmt.Errorf("invalid count: %d", count)
+	}
+	return mc.simpleRequest(newItemReplaceCommand("block").Arg(blockPos(pos)).
+		Literal(slot).Literal("with").Arg(item).Int(count), "Replaced")
+}
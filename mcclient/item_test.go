@@ -0,0 +1,149 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+func TestItemStackRender(t *testing.T) {
+	type testCase struct {
+		item     ItemStack
+		expected string
+	}
+	testCases := []testCase{
+		{ItemStack{ID: "minecraft:diamond"}, "minecraft:diamond"},
+		{ItemStack{ID: "diamond"}, "diamond"},
+		{ItemStack{ID: "minecraft:diamond_sword", Components: nbt.Compound{
+			"minecraft:custom_name": nbt.String(`{"text":"Prize"}`),
+			"minecraft:damage":      nbt.Int(5),
+		}}, `minecraft:diamond_sword[minecraft:custom_name=` +
+			`"{\"text\":\"Prize\"}",minecraft:damage=5]`},
+		{ItemStack{ID: "minecraft:stick", NBT: nbt.Compound{
+			"display": nbt.Compound{"Name": nbt.String("It's mine")},
+		}}, `minecraft:stick{display:{Name:"It's mine"}}`},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			str, err := tcase.item.CommandArgument()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+		})
+	}
+}
+
+func TestItemStackRenderFailures(t *testing.T) {
+	_, err := ItemStack{ID: "Diamond Sword"}.CommandArgument()
+	expectError(t, err, "invalid resource location: Diamond Sword")
+	_, err = ItemStack{ID: "minecraft:stone", Components: nbt.Compound{
+		"bad key": nbt.Int(1),
+	}}.CommandArgument()
+	expectError(t, err, "invalid resource location: bad key")
+}
+
+func TestGiveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		`give @a minecraft:diamond[minecraft:custom_name="\"Prize\""] 3`).
+		Return("Gave 3 [Prize] to 2 players", nil)
+	err := tc.mc.Give(NewSelector(SelectorAllPlayers), ItemStack{
+		ID: "minecraft:diamond",
+		Components: nbt.Compound{
+			"minecraft:custom_name": nbt.String(`"Prize"`),
+		},
+	}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tc.mc.Give(Player("test"), ItemStack{ID: "minecraft:diamond"}, 0)
+	expectError(t, err, "invalid count: 0")
+}
+
+func TestGiveErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("give test minecraft:diamonds 1").
+		Return("Unknown item 'minecraft:diamonds'", nil)
+	err := tc.mc.Give(Player("test"), ItemStack{ID: "minecraft:diamonds"}, 1)
+	expectError(t, err, "Unknown item")
+}
+
+func TestClearSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("clear test minecraft:dirt 10").
+		Return("Removed 10 item(s) from player test", nil)
+	count, err := tc.mc.Clear(Player("test"),
+		&ItemStack{ID: "minecraft:dirt"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 10 {
+		t.Errorf("Expected: 10 Got: %d", count)
+	}
+
+	tc.client.EXPECT().Request("clear @a").
+		Return("Removed 120 item(s) from 3 players", nil)
+	count, err = tc.mc.Clear(NewSelector(SelectorAllPlayers), nil, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 120 {
+		t.Errorf("Expected: 120 Got: %d", count)
+	}
+
+	tc.client.EXPECT().Request("clear test minecraft:dirt 0").
+		Return("Found 7 matching item(s) on player test", nil)
+	count, err = tc.mc.Clear(Player("test"),
+		&ItemStack{ID: "minecraft:dirt"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 7 {
+		t.Errorf("Expected: 7 Got: %d", count)
+	}
+
+	tc.client.EXPECT().Request("clear test minecraft:dirt").
+		Return("No items were found on player test", nil)
+	count, err = tc.mc.Clear(Player("test"),
+		&ItemStack{ID: "minecraft:dirt"}, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("Expected: 0 Got: %d", count)
+	}
+
+	_, err = tc.mc.Clear(Player("test"), nil, 5)
+	expectError(t, err, "maxCount requires an item")
+}
+
+func TestItemReplaceSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		"item replace entity test armor.head with minecraft:carved_pumpkin 1").
+		Return("Replaced a slot on test with [Carved Pumpkin]", nil)
+	err := tc.mc.ItemReplace(Player("test"), "armor.head",
+		ItemStack{ID: "minecraft:carved_pumpkin"}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(
+		"item replace block 1 64 2 container.0 with minecraft:bread 16").
+		Return("Replaced a slot at 1, 64, 2 with [Bread]", nil)
+	err = tc.mc.ItemReplaceBlock(Pos(1, 64, 2), "container.0",
+		ItemStack{ID: "minecraft:bread"}, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
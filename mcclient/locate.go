@@ -0,0 +1,71 @@
+package client
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	locateRegex *regexp.Regexp = regexp.MustCompile(
+		`^The nearest .+? is at \[(-?\d+), (-?\d+|~), (-?\d+)\] ` +
+			`\((\d+) blocks? away\)`)
+)
+
+// Location is where locate found something and how far away it is from the
+// position the command ran at.
+type Location struct {
+	X, Y, Z int
+	// HasY is false when the server only reports the column, as it does for
+	// structures.
+	HasY     bool
+	Distance int
+}
+
+func (mc *MinecraftClient) locate(kind, id string) (Location, error) {
+	resp, err := mc.request(client.NewCommand("locate").Literal(kind).
+		Literal(id))
+	if err != nil {
+		return Location{}, err
+	}
+	matches, err := parseResponse(resp, locateRegex)
+	if err != nil {
+		return Location{}, err
+	}
+	var loc Location
+	if loc.X, err = strconv.Atoi(matches[1]); err != nil {
+		return Location{}, err
+	}
+	if matches[2] != "~" {
+		if loc.Y, err = strconv.Atoi(matches[2]); err != nil {
+			return Location{}, err
+		}
+		loc.HasY = true
+	}
+	if loc.Z, err = strconv.Atoi(matches[3]); err != nil {
+		return Location{}, err
+	}
+	if loc.Distance, err = strconv.Atoi(matches[4]); err != nil {
+		return Location{}, err
+	}
+	return loc, nil
+}
+
+// LocateStructure finds the nearest structure, such as minecraft:village_plains
+// or the tag #minecraft:village. Errors wrap ErrNotFound if there is none in
+// range.
+func (mc *MinecraftClient) LocateStructure(structure string) (Location,
+	error) {
+	return mc.locate("structure", structure)
+}
+
+// LocateBiome finds the nearest biome, such as minecraft:plains.
+func (mc *MinecraftClient) LocateBiome(biome string) (Location, error) {
+	return mc.locate("biome", biome)
+}
+
+// LocatePOI finds the nearest point of interest, such as minecraft:bee_nest.
+func (mc *MinecraftClient) LocatePOI(poi string) (Location, error) {
+	return mc.locate("poi", poi)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLocateSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("locate structure #minecraft:village").
+		Return("The nearest #minecraft:village (minecraft:village_plains) is "+
+			"at [224, ~, -112] (250 blocks away)", nil)
+	loc, err := tc.mc.LocateStructure("#minecraft:village")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Location{X: 224, Z: -112, Distance: 250}
+	if loc != expected {
+		t.Errorf("Expected: %+v Got: %+v", expected, loc)
+	}
+
+	tc.client.EXPECT().Request("locate biome minecraft:desert").
+		Return("The nearest minecraft:desert is at [-800, 70, 32] "+
+			"(801 blocks away)", nil)
+	loc, err = tc.mc.LocateBiome("minecraft:desert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = Location{X: -800, Y: 70, HasY: true, Z: 32, Distance: 801}
+	if loc != expected {
+		t.Errorf("Expected: %+v Got: %+v", expected, loc)
+	}
+
+	tc.client.EXPECT().Request("locate poi minecraft:bee_nest").
+		Return("The nearest minecraft:bee_nest is at [1, 64, 0] "+
+			"(1 block away)", nil)
+	loc, err = tc.mc.LocatePOI("minecraft:bee_nest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = Location{X: 1, Y: 64, HasY: true, Z: 0, Distance: 1}
+	if loc != expected {
+		t.Errorf("Expected: %+v Got: %+v", expected, loc)
+	}
+}
+
+func TestLocateNotFound(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("locate biome minecraft:mushroom_fields").
+		Return(`Could not find a biome of type "minecraft:mushroom_fields" `+
+			"within reasonable distance", nil)
+	_, err := tc.mc.LocateBiome("minecraft:mushroom_fields")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected: %v Got: %v", ErrNotFound, err)
+	}
+	expectError(t, err, "within reasonable distance")
+}
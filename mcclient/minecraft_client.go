@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/Coderlane/go-minecraft-rcon/client"
@@ -22,11 +23,29 @@ func validateUser(user string) error {
 
 func validateResponsePrefix(response, expected string) error {
 	if !strings.HasPrefix(response, expected) {
-		return fmt.Errorf("%s", response)
+		return newResponseError(response)
 	}
 	return nil
 }
 
+// parseResponse matches the response against re and returns the submatches.
+func parseResponse(response string, re *regexp.Regexp) ([]string, error) {
+	matches := re.FindStringSubmatch(response)
+	if matches == nil {
+		return nil, newResponseError(response)
+	}
+	return matches, nil
+}
+
+// parseResponseInt parses the first submatch of re as an integer.
+func parseResponseInt(response string, re *regexp.Regexp) (int, error) {
+	matches, err := parseResponse(response, re)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(matches[1])
+}
+
 // MinecraftClient is a high level wrapper for the Minecraft RCon API
 type MinecraftClient struct {
 	client client.Client
@@ -39,8 +58,17 @@ func NewMinecraftClient(client client.Client) *MinecraftClient {
 	}
 }
 
-func (mc *MinecraftClient) simpleRequest(cmd, respPrefix string) error {
-	resp, err := mc.client.Request(cmd)
+func (mc *MinecraftClient) request(cmd *client.Command) (string, error) {
+	line, err := cmd.Build()
+	if err != nil {
+		return "", err
+	}
+	return mc.client.Request(line)
+}
+
+func (mc *MinecraftClient) simpleRequest(cmd *client.Command,
+	respPrefix string) error {
+	resp, err := mc.request(cmd)
 	if err != nil {
 		return err
 	}
@@ -54,11 +82,11 @@ func (mc *MinecraftClient) Close() error {
 
 // HelpCmd displays the help for the command \cmd|
 func (mc *MinecraftClient) HelpCmd(cmd string) ([]string, error) {
-	fullCmd := "help"
-	if len(cmd) > 0 {
-		fullCmd += " " + cmd
+	fullCmd := client.NewCommand("help")
+	for _, part := range strings.Fields(cmd) {
+		fullCmd.Literal(part)
 	}
-	data, err := mc.client.Request(fullCmd)
+	data, err := mc.request(fullCmd)
 	if err != nil {
 		return []string{}, err
 	}
@@ -73,7 +101,7 @@ func (mc *MinecraftClient) Help() ([]string, error) {
 
 // UsersList lists users currently logged in to the server.
 func (mc *MinecraftClient) UsersList() ([]string, error) {
-	data, err := mc.client.Request("list")
+	data, err := mc.request(client.NewCommand("list"))
 	if err != nil {
 		return []string{}, err
 	}
@@ -97,7 +125,7 @@ func (mc *MinecraftClient) UserBan(user string) error {
 		return err
 	}
 	return mc.simpleRequest(
-		fmt.Sprintf("ban %s", user), "Banned")
+		client.NewCommand("ban").Word(user), "Banned")
 }
 
 // UserPardon pardons a user by name
@@ -106,17 +134,32 @@ func (mc *MinecraftClient) UserPardon(user string) error {
 		return err
 	}
 	return mc.simpleRequest(
-		fmt.Sprintf("pardon %s", user), "Unbanned")
+		client.NewCommand("pardon").Word(user), "Unbanned")
 }
 
 // IPBan bans an IP address
 func (mc *MinecraftClient) IPBan(ip net.IP) error {
 	return mc.simpleRequest(
-		fmt.Sprintf("ban-ip %s", ip.String()), "Banned IP")
+		client.NewCommand("ban-ip").Literal(ip.String()), "Banned IP")
 }
 
 // IPPardon pardons an IP address
 func (mc *MinecraftClient) IPPardon(ip net.IP) error {
 	return mc.simpleRequest(
-		fmt.Sprintf("pardon-ip %s", ip.String()), "Unbanned IP")
+		client.NewCommand("pardon-ip").Literal(ip.String()), "Unbanned IP")
+}
+
+// Say broadcasts a message to every player.
+func (mc *MinecraftClient) Say(message string) error {
+	_, err := mc.request(client.NewCommand("say").Text(message))
+	return err
+}
+
+// Kick disconnects the targeted players, showing them reason if it is set.
+func (mc *MinecraftClient) Kick(target Target, reason string) error {
+	cmd := client.NewCommand("kick").Arg(target)
+	if len(reason) > 0 {
+		cmd.Text(reason)
+	}
+	return mc.simpleRequest(cmd, "Kicked")
 }
@@ -106,6 +106,24 @@ func TestHelpCmdSuccess(t *testing.T) {
 	}
 }
 
+func TestHelpCmdMultipleWords(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("help scoreboard players").
+		Return("/scoreboard players list [<target>]"+
+			"/scoreboard players get <target> <objective>", nil)
+	cmds, err := tc.mc.HelpCmd("scoreboard players")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"scoreboard players list [<target>]",
+		"scoreboard players get <target> <objective>"}
+	if !reflect.DeepEqual(cmds, expected) {
+		t.Errorf("Got: %v Expected: %v", cmds, expected)
+	}
+}
+
 func TestIPBanPardonSuccess(t *testing.T) {
 	tc := newTestContext(t)
 	defer tc.Finish()
@@ -180,3 +198,28 @@ func TestUserPardonErrorReturned(t *testing.T) {
 	err := tc.mc.UserPardon("test")
 	expectError(t, err, "Nothing changed.")
 }
+
+func TestSaySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+	tc.client.EXPECT().Request("say Hello @a!").Return("", nil)
+	if err := tc.mc.Say("Hello @a!"); err != nil {
+		t.Fatal(err)
+	}
+	err := tc.mc.Say("Hello\nop test")
+	expectError(t, err, "invalid character")
+}
+
+func TestKickSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+	tc.client.EXPECT().Request("kick test Go to bed").
+		Return("Kicked test: Go to bed", nil)
+	if err := tc.mc.Kick(Player("test"), "Go to bed"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("kick @a").
+		Return("No player was found", nil)
+	err := tc.mc.Kick(NewSelector(SelectorAllPlayers), "")
+	expectError(t, err, "No player was found")
+}
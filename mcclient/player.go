@@ -0,0 +1,197 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+// Position is where an entity is and which way it is facing.
+type Position struct {
+	X, Y, Z   float64
+	Dimension string
+	Yaw       float64
+	Pitch     float64
+}
+
+// Experience is a player's experience.
+type Experience struct {
+	Level int
+	// Progress is how far the player is towards the next level, from 0 to 1.
+	Progress float64
+	Total    int
+}
+
+// Item is a stack of items in an inventory.
+type Item struct {
+	Slot  int
+	ID    string
+	Count int
+	// Components holds the item's data components on 1.20.5 and newer.
+	Components nbt.Compound
+	// Tag holds the item's NBT on versions before 1.20.5.
+	Tag nbt.Compound
+}
+
+func asCompound(tag nbt.Tag) (nbt.Compound, error) {
+	compound, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("expected compound: %v", tag)
+	}
+	return compound, nil
+}
+
+func getNumber(compound nbt.Compound, key string) (float64, error) {
+	num, ok := nbt.Number(compound[key])
+	if !ok {
+		return 0, fmt.Errorf("expected number for %s: %v", key, compound[key])
+	}
+	return num, nil
+}
+
+func getNumbers(compound nbt.Compound, key string,
+	count int) ([]float64, error) {
+	list, ok := compound[key].(nbt.List)
+	if !ok || len(list) != count {
+		return nil, fmt.Errorf("expected %d numbers for %s: %v",
+			count, key, compound[key])
+	}
+	nums := make([]float64, count)
+	for i, tag := range list {
+		if nums[i], ok = nbt.Number(tag); !ok {
+			return nil, fmt.Errorf("expected number in %s: %v", key, tag)
+		}
+	}
+	return nums, nil
+}
+
+func (mc *MinecraftClient) entityData(target Target) (nbt.Compound, error) {
+	data, err := mc.DataGetEntity(target, "")
+	if err != nil {
+		return nil, err
+	}
+	return asCompound(data)
+}
+
+// PlayerPosition returns the position, dimension and rotation of a player.
+func (mc *MinecraftClient) PlayerPosition(target Target) (Position, error) {
+	data, err := mc.entityData(target)
+	if err != nil {
+		return Position{}, err
+	}
+	pos, err := getNumbers(data, "Pos", 3)
+	if err != nil {
+		return Position{}, err
+	}
+	rotation, err := getNumbers(data, "Rotation", 2)
+	if err != nil {
+		return Position{}, err
+	}
+	dimension, _ := data["Dimension"].(nbt.String)
+	return Position{
+		X:         pos[0],
+		Y:         pos[1],
+		Z:         pos[2],
+		Dimension: string(dimension),
+		Yaw:       rotation[0],
+		Pitch:     rotation[1],
+	}, nil
+}
+
+// PlayerHealth returns the health of a player, where 20 is full health.
+func (mc *MinecraftClient) PlayerHealth(target Target) (float64, error) {
+	data, err := mc.DataGetEntity(target, "Health")
+	if err != nil {
+		return 0, err
+	}
+	health, ok := nbt.Number(data)
+	if !ok {
+		return 0, fmt.Errorf("expected number for Health: %v", data)
+	}
+	return health, nil
+}
+
+// PlayerXP returns the experience of a player.
+func (mc *MinecraftClient) PlayerXP(target Target) (Experience, error) {
+	data, err := mc.entityData(target)
+	if err != nil {
+		return Experience{}, err
+	}
+	level, err := getNumber(data, "XpLevel")
+	if err != nil {
+		return Experience{}, err
+	}
+	progress, err := getNumber(data, "XpP")
+	if err != nil {
+		return Experience{}, err
+	}
+	total, err := getNumber(data, "XpTotal")
+	if err != nil {
+		return Experience{}, err
+	}
+	return Experience{
+		Level:    int(level),
+		Progress: progress,
+		Total:    int(total),
+	}, nil
+}
+
+func parseItem(tag nbt.Tag) (Item, error) {
+	data, err := asCompound(tag)
+	if err != nil {
+		return Item{}, err
+	}
+	id, ok := data["id"].(nbt.String)
+	if !ok {
+		return Item{}, fmt.Errorf("expected id: %v", data)
+	}
+	item := Item{
+		ID: string(id),
+	}
+	if slot, err := getNumber(data, "Slot"); err == nil {
+		item.Slot = int(slot)
+	}
+	// Items were renamed from Count to count in 1.20.5.
+	count, err := getNumber(data, "count")
+	if err != nil {
+		count, err = getNumber(data, "Count")
+	}
+	if err != nil {
+		// Single items may leave the count out.
+		count = 1
+	}
+	item.Count = int(count)
+	item.Components, _ = data["components"].(nbt.Compound)
+	item.Tag, _ = data["tag"].(nbt.Compound)
+	return item, nil
+}
+
+func (mc *MinecraftClient) items(target Target, path string) ([]Item, error) {
+	data, err := mc.DataGetEntity(target, path)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := data.(nbt.List)
+	if !ok {
+		return nil, fmt.Errorf("expected list for %s: %v", path, data)
+	}
+	items := make([]Item, 0, len(list))
+	for _, tag := range list {
+		item, err := parseItem(tag)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// PlayerInventory returns the items in a player's inventory.
+func (mc *MinecraftClient) PlayerInventory(target Target) ([]Item, error) {
+	return mc.items(target, "Inventory")
+}
+
+// PlayerEnderChest returns the items in a player's ender chest.
+func (mc *MinecraftClient) PlayerEnderChest(target Target) ([]Item, error) {
+	return mc.items(target, "EnderItems")
+}
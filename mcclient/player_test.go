@@ -0,0 +1,131 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+const (
+	testPlayerData string = "test has the following entity data: {" +
+		"Pos: [1.5d, 64.0d, -2.5d], Rotation: [90.0f, -10.5f], " +
+		`Dimension: "minecraft:the_nether", XpLevel: 30, XpP: 0.5f, ` +
+		"XpTotal: 1500, Health: 20.0f}"
+)
+
+func TestPlayerPositionSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test").
+		Return(testPlayerData, nil)
+	pos, err := tc.mc.PlayerPosition(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Position{
+		X: 1.5, Y: 64, Z: -2.5,
+		Dimension: "minecraft:the_nether",
+		Yaw:       90,
+		Pitch:     -10.5,
+	}
+	if pos != expected {
+		t.Errorf("\nExpected: %+v\nGot:      %+v", expected, pos)
+	}
+}
+
+func TestPlayerPositionInvalidData(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test").
+		Return("test has the following entity data: {Pos: [1.0d]}", nil)
+	_, err := tc.mc.PlayerPosition(Player("test"))
+	expectError(t, err, "expected 3 numbers for Pos")
+}
+
+func TestPlayerHealthSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test Health").
+		Return("test has the following entity data: 15.5f", nil)
+	health, err := tc.mc.PlayerHealth(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health != 15.5 {
+		t.Errorf("Expected: 15.5 Got: %v", health)
+	}
+}
+
+func TestPlayerXPSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test").
+		Return(testPlayerData, nil)
+	xp, err := tc.mc.PlayerXP(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Experience{Level: 30, Progress: 0.5, Total: 1500}
+	if xp != expected {
+		t.Errorf("\nExpected: %+v\nGot:      %+v", expected, xp)
+	}
+}
+
+func TestPlayerInventorySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test Inventory").
+		Return("test has the following entity data: ["+
+			`{Slot: 0b, id: "minecraft:stone", count: 64}, `+
+			`{Slot: 1b, id: "minecraft:diamond_sword", count: 1, `+
+			`components: {"minecraft:damage": 5}}]`, nil)
+	items, err := tc.mc.PlayerInventory(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Item{
+		{Slot: 0, ID: "minecraft:stone", Count: 64},
+		{Slot: 1, ID: "minecraft:diamond_sword", Count: 1,
+			Components: nbt.Compound{"minecraft:damage": nbt.Int(5)}},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("\nExpected: %+v\nGot:      %+v", expected, items)
+	}
+}
+
+func TestPlayerEnderChestLegacySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test EnderItems").
+		Return("test has the following entity data: ["+
+			`{Slot: 3b, id: "minecraft:apple", Count: 5b, `+
+			`tag: {Damage: 0}}]`, nil)
+	items, err := tc.mc.PlayerEnderChest(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Item{
+		{Slot: 3, ID: "minecraft:apple", Count: 5,
+			Tag: nbt.Compound{"Damage": nbt.Int(0)}},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("\nExpected: %+v\nGot:      %+v", expected, items)
+	}
+}
+
+func TestPlayerInventoryErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("data get entity test Inventory").
+		Return("No entity was found", nil)
+	_, err := tc.mc.PlayerInventory(Player("test"))
+	expectError(t, err, "No entity was found")
+}
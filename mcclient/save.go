@@ -0,0 +1,111 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+// BackupTimings records how long each step of a backup took.
+type BackupTimings struct {
+	SaveOff time.Duration
+	Flush   time.Duration
+	Copy    time.Duration
+	SaveOn  time.Duration
+	Total   time.Duration
+}
+
+// SaveAll saves the world. If flush is set, all chunks are written to disk
+// before the server responds.
+func (mc *MinecraftClient) SaveAll(flush bool) error {
+	cmd := client.NewCommand("save-all")
+	if flush {
+		cmd.Literal("flush")
+	}
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return err
+	}
+	if err := validateResponsePrefix(resp, "Saving the game"); err != nil {
+		return err
+	}
+	if !strings.Contains(resp, "Saved the game") {
+		return newResponseError(resp)
+	}
+	return nil
+}
+
+// SaveOff disables automatic saving. Saving that is already off is not an
+// error.
+func (mc *MinecraftClient) SaveOff() error {
+	_, err := mc.saveOff()
+	return err
+}
+
+// saveOff disables automatic saving and reports whether it was on before.
+func (mc *MinecraftClient) saveOff() (bool, error) {
+	resp, err := mc.request(client.NewCommand("save-off"))
+	if err != nil {
+		return false, err
+	}
+	if strings.HasPrefix(resp, "Saving is already turned off") {
+		return false, nil
+	}
+	err = validateResponsePrefix(resp, "Automatic saving is now disabled")
+	return err == nil, err
+}
+
+// SaveOn enables automatic saving. Saving that is already on is not an error.
+func (mc *MinecraftClient) SaveOn() error {
+	resp, err := mc.request(client.NewCommand("save-on"))
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(resp, "Saving is already turned on") {
+		return nil
+	}
+	return validateResponsePrefix(resp, "Automatic saving is now enabled")
+}
+
+// Backup disables automatic saving, flushes the world to disk and then calls
+// copyWorld to copy it somewhere safe. If Backup turned saving off, it is
+// turned back on afterwards, even if a step fails or copyWorld panics. Saving
+// that was already off is left off.
+func (mc *MinecraftClient) Backup(
+	copyWorld func() error) (timings BackupTimings, err error) {
+	start := time.Now()
+	disabled := false
+	defer func() {
+		if disabled {
+			step := time.Now()
+			saveErr := mc.SaveOn()
+			timings.SaveOn = time.Since(step)
+			if err == nil && saveErr != nil {
+				err = fmt.Errorf("save-on: %w", saveErr)
+			}
+		}
+		timings.Total = time.Since(start)
+	}()
+
+	step := time.Now()
+	disabled, err = mc.saveOff()
+	timings.SaveOff = time.Since(step)
+	if err != nil {
+		return timings, fmt.Errorf("save-off: %w", err)
+	}
+
+	step = time.Now()
+	if err = mc.SaveAll(true); err != nil {
+		return timings, fmt.Errorf("save-all: %w", err)
+	}
+	timings.Flush = time.Since(step)
+
+	step = time.Now()
+	if err = copyWorld(); err != nil {
+		return timings, fmt.Errorf("copy: %w", err)
+	}
+	timings.Copy = time.Since(step)
+	return timings, nil
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	saveAllResponse string = "Saving the game (this may take a moment!)" +
+		"Saved the game"
+)
+
+func TestSaveAllSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("save-all").Return(saveAllResponse, nil)
+	if err := tc.mc.SaveAll(false); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("save-all flush").Return(saveAllResponse, nil)
+	if err := tc.mc.SaveAll(true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSaveAllErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("save-all flush").
+		Return("Saving the game (this may take a moment!)", nil)
+	err := tc.mc.SaveAll(true)
+	expectError(t, err, "Saving the game")
+	tc.client.EXPECT().Request("save-all flush").
+		Return("Unable to save the game (is there enough disk space?)", nil)
+	err = tc.mc.SaveAll(true)
+	expectError(t, err, "Unable to save the game")
+}
+
+func TestSaveOffOnSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("save-off").
+		Return("Automatic saving is now disabled", nil)
+	if err := tc.mc.SaveOff(); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("save-off").
+		Return("Saving is already turned off", nil)
+	if err := tc.mc.SaveOff(); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("save-on").
+		Return("Automatic saving is now enabled", nil)
+	if err := tc.mc.SaveOn(); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("save-on").
+		Return("Saving is already turned on", nil)
+	if err := tc.mc.SaveOn(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (tc *testContext) expectBackup(flushResp string) {
+	gomock.InOrder(
+		tc.client.EXPECT().Request("save-off").
+			Return("Automatic saving is now disabled", nil),
+		tc.client.EXPECT().Request("save-all flush").
+			Return(flushResp, nil),
+		tc.client.EXPECT().Request("save-on").
+			Return("Automatic saving is now enabled", nil),
+	)
+}
+
+func TestBackupSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.expectBackup(saveAllResponse)
+	copied := false
+	timings, err := tc.mc.Backup(func() error {
+		copied = true
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !copied {
+		t.Error("Expected the world to be copied")
+	}
+	if timings.Copy < time.Millisecond || timings.Total < timings.Copy {
+		t.Errorf("Unexpected timings: %+v", timings)
+	}
+}
+
+func TestBackupCopyFails(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.expectBackup(saveAllResponse)
+	_, err := tc.mc.Backup(func() error {
+		return fmt.Errorf("disk full")
+	})
+	expectError(t, err, "copy: disk full")
+}
+
+func TestBackupFlushFails(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.expectBackup("Unable to save the game (is there enough disk space?)")
+	_, err := tc.mc.Backup(func() error {
+		t.Error("Expected copy to be skipped")
+		return nil
+	})
+	expectError(t, err, "save-all: Unable to save the game")
+}
+
+func TestBackupCopyPanics(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.expectBackup(saveAllResponse)
+	defer func() {
+		if r := recover(); r != "copy panicked" {
+			t.Errorf("Expected panic to propagate: %v", r)
+		}
+	}()
+	tc.mc.Backup(func() error {
+		panic("copy panicked")
+	})
+}
+
+func TestBackupSavingAlreadyOff(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	gomock.InOrder(
+		tc.client.EXPECT().Request("save-off").
+			Return("Saving is already turned off", nil),
+		tc.client.EXPECT().Request("save-all flush").
+			Return(saveAllResponse, nil),
+	)
+	_, err := tc.mc.Backup(func() error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBackupSaveOffFails(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("save-off").
+		Return("Unknown or incomplete command, see below for error", nil)
+	_, err := tc.mc.Backup(func() error {
+		t.Error("Expected copy to be skipped")
+		return nil
+	})
+	expectError(t, err, "save-off: Unknown or incomplete command")
+}
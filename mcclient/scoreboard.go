@@ -0,0 +1,237 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	scoreHolderRegex *regexp.Regexp = regexp.MustCompile(`^[^\s@]\S{0,39}$`)
+	scoreGetRegex    *regexp.Regexp = regexp.MustCompile(
+		`^.+? has (-?\d+) \[.*\]$`)
+	scoreEntryRegex *regexp.Regexp = regexp.MustCompile(
+		`\[(.*?)\]: (-?\d+)`)
+	trackedRegex *regexp.Regexp = regexp.MustCompile(
+		`^There are \d+ tracked (?:entity/entities|entities|entity): (.*)$`)
+)
+
+// ScoreHolder targets a score holder by name. Unlike Player, it allows the
+// fake players often used to hold global state, such as #timer. The name *
+// selects every score holder.
+type ScoreHolder string
+
+// CommandArgument validates and returns the score holder.
+func (holder ScoreHolder) CommandArgument() (string, error) {
+	if !scoreHolderRegex.MatchString(string(holder)) {
+		return "", fmt.Errorf("invalid score holder: %s", string(holder))
+	}
+	return string(holder), nil
+}
+
+// ScoreOp is an operation for ScoreOperation.
+type ScoreOp string
+
+const (
+	// ScoreOpAdd adds the source score to the target score.
+	ScoreOpAdd ScoreOp = "+="
+	// ScoreOpSubtract subtracts the source score from the target score.
+	ScoreOpSubtract ScoreOp = "-="
+	// ScoreOpMultiply multiplies the target score by the source score.
+	ScoreOpMultiply ScoreOp = "*="
+	// ScoreOpDivide divides the target score by the source score.
+	ScoreOpDivide ScoreOp = "/="
+	// ScoreOpModulo sets the target score to the remainder of the division.
+	ScoreOpModulo ScoreOp = "%="
+	// ScoreOpAssign sets the target score to the source score.
+	ScoreOpAssign ScoreOp = "="
+	// ScoreOpMin sets the target score to the smaller of the scores.
+	ScoreOpMin ScoreOp = "<"
+	// ScoreOpMax sets the target score to the larger of the scores.
+	ScoreOpMax ScoreOp = ">"
+	// ScoreOpSwap swaps the scores.
+	ScoreOpSwap ScoreOp = "><"
+)
+
+// CommandArgument validates and returns the operation.
+func (op ScoreOp) CommandArgument() (string, error) {
+	switch op {
+	case ScoreOpAdd, ScoreOpSubtract, ScoreOpMultiply, ScoreOpDivide,
+		ScoreOpModulo, ScoreOpAssign, ScoreOpMin, ScoreOpMax, ScoreOpSwap:
+		return string(op), nil
+	}
+	return "", fmt.Errorf("invalid score operation: %s", string(op))
+}
+
+// parseBracketedList parses responses like "There are 2 teams: [a], [b]",
+// returning an empty list if the response starts with empty instead. Names
+// may contain brackets, but a name containing "], [" is split in two.
+func parseBracketedList(resp, prefix, empty string) ([]string, error) {
+	if strings.HasPrefix(resp, empty) {
+		return []string{}, nil
+	}
+	if err := validateResponsePrefix(resp, prefix); err != nil {
+		return nil, err
+	}
+	idx := strings.Index(resp, ": [")
+	if idx < 0 || !strings.HasSuffix(resp, "]") {
+		return nil, newResponseError(resp)
+	}
+	return strings.Split(resp[idx+3:len(resp)-1], "], ["), nil
+}
+
+func newObjectivesCommand(subcommand string) *client.Command {
+	return client.NewCommand("scoreboard").Literal("objectives").
+		Literal(subcommand)
+}
+
+func newPlayersCommand(subcommand string) *client.Command {
+	return client.NewCommand("scoreboard").Literal("players").
+		Literal(subcommand)
+}
+
+// ObjectiveAdd creates an objective tracking criterion, such as dummy or
+// minecraft.killed:minecraft.zombie. If displayName is nil, the name is shown.
+func (mc *MinecraftClient) ObjectiveAdd(name, criterion string,
+	displayName *TextComponent) error {
+	cmd := newObjectivesCommand("add").Literal(name).Literal(criterion)
+	if displayName != nil {
+		cmd.Arg(displayName)
+	}
+	return mc.simpleRequest(cmd, "Created new objective")
+}
+
+// ObjectiveRemove removes an objective and all of its scores.
+func (mc *MinecraftClient) ObjectiveRemove(name string) error {
+	return mc.simpleRequest(newObjectivesCommand("remove").Literal(name),
+		"Removed objective")
+}
+
+// ObjectiveList returns the display names of every objective. Display names
+// are not the objective names that ObjectiveRemove and the score methods
+// take, unless the objective was created without a display name.
+func (mc *MinecraftClient) ObjectiveList() (displayNames []string,
+	err error) {
+	resp, err := mc.request(newObjectivesCommand("list"))
+	if err != nil {
+		return nil, err
+	}
+	return parseBracketedList(resp, "There are", "There are no objectives")
+}
+
+// ObjectiveSetDisplay shows an objective in a display slot, such as sidebar
+// or list. An empty objective clears the slot.
+func (mc *MinecraftClient) ObjectiveSetDisplay(slot, objective string) error {
+	cmd := newObjectivesCommand("setdisplay").Literal(slot)
+	if len(objective) == 0 {
+		return mc.simpleRequest(cmd, "Cleared any objectives in display slot")
+	}
+	return mc.simpleRequest(cmd.Literal(objective), "Set display slot")
+}
+
+// ScoreSet sets the score of the targets for objective.
+func (mc *MinecraftClient) ScoreSet(target Target, objective string,
+	value int) error {
+	return mc.simpleRequest(newPlayersCommand("set").Arg(target).
+		Literal(objective).Int(value), "Set ")
+}
+
+// ScoreAdd adds amount to the score of the targets for objective.
+func (mc *MinecraftClient) ScoreAdd(target Target, objective string,
+	amount int) error {
+	if amount < 0 {
+		return fmt.Errorf("invalid amount: %d", amount)
+	}
+	return mc.simpleRequest(newPlayersCommand("add").Arg(target).
+		Literal(objective).Int(amount), "Added")
+}
+
+// ScoreRemove subtracts amount from the score of the targets for objective.
+func (mc *MinecraftClient) ScoreRemove(target Target, objective string,
+	amount int) error {
+	if amount < 0 {
+		return fmt.Errorf("invalid amount: %d", amount)
+	}
+	return mc.simpleRequest(newPlayersCommand("remove").Arg(target).
+		Literal(objective).Int(amount), "Removed")
+}
+
+// ScoreGet returns the score of a single target for objective.
+func (mc *MinecraftClient) ScoreGet(target Target,
+	objective string) (int, error) {
+	resp, err := mc.request(newPlayersCommand("get").Arg(target).
+		Literal(objective))
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, scoreGetRegex)
+}
+
+// ScoreList returns the names of every entity with a score.
+func (mc *MinecraftClient) ScoreList() ([]string, error) {
+	resp, err := mc.request(newPlayersCommand("list"))
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(resp, "There are no tracked entities") {
+		return []string{}, nil
+	}
+	matches, err := parseResponse(resp, trackedRegex)
+	if err != nil {
+		return nil, err
+	}
+	names := strings.Split(matches[1], ",")
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+	}
+	return names, nil
+}
+
+// ScoreListEntity returns the scores of a single target, keyed by the display
+// name of each objective.
+func (mc *MinecraftClient) ScoreListEntity(target Target) (map[string]int,
+	error) {
+	resp, err := mc.request(newPlayersCommand("list").Arg(target))
+	if err != nil {
+		return nil, err
+	}
+	scores := make(map[string]int)
+	if strings.HasSuffix(resp, " has no scores to show") {
+		return scores, nil
+	}
+	idx := strings.Index(resp, " score(s):")
+	if idx < 0 {
+		return nil, newResponseError(resp)
+	}
+	for _, match := range scoreEntryRegex.FindAllStringSubmatch(
+		resp[idx:], -1) {
+		score, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, err
+		}
+		scores[match[1]] = score
+	}
+	return scores, nil
+}
+
+// ScoreReset clears the scores of the targets for objective, or for every
+// objective if objective is empty.
+func (mc *MinecraftClient) ScoreReset(target Target, objective string) error {
+	cmd := newPlayersCommand("reset").Arg(target)
+	if len(objective) > 0 {
+		cmd.Literal(objective)
+	}
+	return mc.simpleRequest(cmd, "Reset ")
+}
+
+// ScoreOperation applies op to the score of the targets for objective, using
+// the score of source for sourceObjective.
+func (mc *MinecraftClient) ScoreOperation(target Target, objective string,
+	op ScoreOp, source Target, sourceObjective string) error {
+	return mc.simpleRequest(newPlayersCommand("operation").Arg(target).
+		Literal(objective).Arg(op).Arg(source).Literal(sourceObjective),
+		"Set ")
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestObjectiveAddRemoveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`scoreboard objectives add kills `+
+		`minecraft.killed:minecraft.zombie {"text":"Zombie Kills"}`).
+		Return("Created new objective [Zombie Kills]", nil)
+	name := Text("Zombie Kills")
+	err := tc.mc.ObjectiveAdd("kills", "minecraft.killed:minecraft.zombie",
+		&name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("scoreboard objectives add timer dummy").
+		Return("An objective already exists by that name", nil)
+	err = tc.mc.ObjectiveAdd("timer", "dummy", nil)
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists: %v", err)
+	}
+	tc.client.EXPECT().Request("scoreboard objectives remove kills").
+		Return("Removed objective [Zombie Kills]", nil)
+	if err := tc.mc.ObjectiveRemove("kills"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestObjectiveListSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard objectives list").
+		Return("There are 3 objective(s): [Kills], [timer], [[Deaths]]", nil)
+	objectives, err := tc.mc.ObjectiveList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Kills", "timer", "[Deaths]"}
+	if !reflect.DeepEqual(objectives, expected) {
+		t.Errorf("Got: %v Expected: %v", objectives, expected)
+	}
+
+	tc.client.EXPECT().Request("scoreboard objectives list").
+		Return("There are no objectives", nil)
+	objectives, err = tc.mc.ObjectiveList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objectives) != 0 {
+		t.Errorf("Expected no objectives: %v", objectives)
+	}
+}
+
+func TestObjectiveSetDisplaySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard objectives setdisplay sidebar kills").
+		Return("Set display slot sidebar to show objective Kills", nil)
+	if err := tc.mc.ObjectiveSetDisplay("sidebar", "kills"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("scoreboard objectives setdisplay sidebar").
+		Return("Cleared any objectives in display slot sidebar", nil)
+	if err := tc.mc.ObjectiveSetDisplay("sidebar", ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScoreSetAddRemoveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players set #timer timer 60").
+		Return("Set [timer] for #timer to 60", nil)
+	if err := tc.mc.ScoreSet(ScoreHolder("#timer"), "timer", 60); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("scoreboard players add @a[team=red] kills 1").
+		Return("Added 1 to [Kills] for 3 entities", nil)
+	err := tc.mc.ScoreAdd(NewSelector(SelectorAllPlayers).Team("red"),
+		"kills", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("scoreboard players remove test kills 2").
+		Return("Removed 2 from [Kills] for test (now 3)", nil)
+	if err := tc.mc.ScoreRemove(Player("test"), "kills", 2); err != nil {
+		t.Fatal(err)
+	}
+	err = tc.mc.ScoreAdd(Player("test"), "kills", -1)
+	expectError(t, err, "invalid amount: -1")
+}
+
+func TestScoreSetUnknownObjective(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players set test deaths 1").
+		Return("Unknown scoreboard objective 'deaths'", nil)
+	err := tc.mc.ScoreSet(Player("test"), "deaths", 1)
+	if !errors.Is(err, ErrUnknownObjective) {
+		t.Errorf("Expected ErrUnknownObjective: %v", err)
+	}
+}
+
+func TestScoreGetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players get test kills").
+		Return("test has -5 [Kills]", nil)
+	score, err := tc.mc.ScoreGet(Player("test"), "kills")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != -5 {
+		t.Errorf("Expected: -5 Got: %d", score)
+	}
+
+	tc.client.EXPECT().Request("scoreboard players get test deaths").
+		Return("test has 7 [[Deaths]]", nil)
+	score, err = tc.mc.ScoreGet(Player("test"), "deaths")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != 7 {
+		t.Errorf("Expected: 7 Got: %d", score)
+	}
+}
+
+func TestScoreGetNoScore(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players get test kills").
+		Return("Can't get value of kills for test; none is set", nil)
+	_, err := tc.mc.ScoreGet(Player("test"), "kills")
+	if !errors.Is(err, ErrNoScore) {
+		t.Errorf("Expected ErrNoScore: %v", err)
+	}
+}
+
+func TestScoreListSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players list").
+		Return("There are 2 tracked entity/entities: test, #timer", nil)
+	names, err := tc.mc.ScoreList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"test", "#timer"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Got: %v Expected: %v", names, expected)
+	}
+
+	tc.client.EXPECT().Request("scoreboard players list").
+		Return("There are no tracked entities", nil)
+	names, err = tc.mc.ScoreList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 0 {
+		t.Errorf("Expected no entities: %v", names)
+	}
+}
+
+func TestScoreListEntitySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players list test").
+		Return("test has 3 score(s):[Kills]: 10[[Deaths]]: 4[timer]: -3", nil)
+	scores, err := tc.mc.ScoreListEntity(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int{"Kills": 10, "[Deaths]": 4, "timer": -3}
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("Got: %v Expected: %v", scores, expected)
+	}
+
+	tc.client.EXPECT().Request("scoreboard players list test").
+		Return("test has no scores to show", nil)
+	scores, err = tc.mc.ScoreListEntity(Player("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scores) != 0 {
+		t.Errorf("Expected no scores: %v", scores)
+	}
+}
+
+func TestScoreResetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("scoreboard players reset * kills").
+		Return("Reset [Kills] for 4 entities", nil)
+	if err := tc.mc.ScoreReset(ScoreHolder("*"), "kills"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("scoreboard players reset test").
+		Return("Reset all scores for test", nil)
+	if err := tc.mc.ScoreReset(Player("test"), ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScoreOperationSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(
+		"scoreboard players operation test total += #bonus points").
+		Return("Set [total] for test to 15", nil)
+	err := tc.mc.ScoreOperation(Player("test"), "total", ScoreOpAdd,
+		ScoreHolder("#bonus"), "points")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tc.mc.ScoreOperation(Player("test"), "total", "^=",
+		ScoreHolder("#bonus"), "points")
+	expectError(t, err, "invalid score operation: ^=")
+	err = tc.mc.ScoreSet(ScoreHolder("bad name"), "total", 1)
+	expectError(t, err, "invalid score holder: bad name")
+}
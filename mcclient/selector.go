@@ -0,0 +1,340 @@
+package client
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/nbt"
+)
+
+var (
+	selectorValueRegex *regexp.Regexp = regexp.MustCompile(`^[\w\-\.\+:/#]*$`)
+)
+
+// Target is a player name or an entity selector that commands can target.
+type Target interface {
+	client.Argument
+}
+
+// Player targets a single player by name.
+type Player string
+
+// CommandArgument validates and returns the player name.
+func (player Player) CommandArgument() (string, error) {
+	if err := validateUser(string(player)); err != nil {
+		return "", err
+	}
+	return string(player), nil
+}
+
+// SelectorBase is the variable a selector starts with.
+type SelectorBase string
+
+const (
+	// SelectorNearestPlayer selects the nearest player, @p.
+	SelectorNearestPlayer SelectorBase = "@p"
+	// SelectorAllPlayers selects every player, @a.
+	SelectorAllPlayers SelectorBase = "@a"
+	// SelectorRandomPlayer selects a random player, @r.
+	SelectorRandomPlayer SelectorBase = "@r"
+	// SelectorSelf selects the entity executing the command, @s.
+	SelectorSelf SelectorBase = "@s"
+	// SelectorAllEntities selects every entity, @e.
+	SelectorAllEntities SelectorBase = "@e"
+)
+
+// SelectorSort is the order entities are selected in.
+type SelectorSort string
+
+const (
+	// SortNearest sorts by increasing distance.
+	SortNearest SelectorSort = "nearest"
+	// SortFurthest sorts by decreasing distance.
+	SortFurthest SelectorSort = "furthest"
+	// SortRandom sorts randomly.
+	SortRandom SelectorSort = "random"
+	// SortArbitrary does not sort.
+	SortArbitrary SelectorSort = "arbitrary"
+)
+
+// Range is an inclusive range of numbers, as used by distance=.
+type Range struct {
+	Min, Max       float64
+	HasMin, HasMax bool
+}
+
+// Exactly matches only value.
+func Exactly(value float64) Range {
+	return Range{Min: value, Max: value, HasMin: true, HasMax: true}
+}
+
+// AtLeast matches value and anything larger.
+func AtLeast(min float64) Range {
+	return Range{Min: min, HasMin: true}
+}
+
+// AtMost matches value and anything smaller.
+func AtMost(max float64) Range {
+	return Range{Max: max, HasMax: true}
+}
+
+// Between matches anything from min to max.
+func Between(min, max float64) Range {
+	return Range{Min: min, Max: max, HasMin: true, HasMax: true}
+}
+
+func formatNumber(num float64) string {
+	return strconv.FormatFloat(num, 'f', -1, 64)
+}
+
+// String formats the range as min..max.
+func (r Range) String() string {
+	switch {
+	case r.HasMin && r.HasMax && r.Min == r.Max:
+		return formatNumber(r.Min)
+	case r.HasMin && r.HasMax:
+		return formatNumber(r.Min) + ".." + formatNumber(r.Max)
+	case r.HasMin:
+		return formatNumber(r.Min) + ".."
+	case r.HasMax:
+		return ".." + formatNumber(r.Max)
+	}
+	return ""
+}
+
+func (r Range) validate(integer bool) error {
+	if !r.HasMin && !r.HasMax {
+		return fmt.Errorf("empty range")
+	}
+	if r.HasMin && r.HasMax && r.Min > r.Max {
+		return fmt.Errorf("invalid range: %s", r)
+	}
+	if integer && (r.Min != math.Trunc(r.Min) || r.Max != math.Trunc(r.Max)) {
+		return fmt.Errorf("invalid integer range: %s", r)
+	}
+	return nil
+}
+
+// Selector builds a target selector such as @a[team=red,limit=3].
+type Selector struct {
+	base   SelectorBase
+	args   []string
+	scores []string
+	err    error
+}
+
+// NewSelector starts a new selector from base.
+func NewSelector(base SelectorBase) *Selector {
+	return &Selector{
+		base: base,
+	}
+}
+
+func (sel *Selector) add(key, value string, err error) *Selector {
+	if sel.err != nil {
+		return sel
+	}
+	if err != nil {
+		sel.err = err
+		return sel
+	}
+	sel.args = append(sel.args, key+"="+value)
+	return sel
+}
+
+func negate(value string, not bool) string {
+	if not {
+		return "!" + value
+	}
+	return value
+}
+
+func validateSelectorValue(value string) error {
+	if !selectorValueRegex.MatchString(value) {
+		return fmt.Errorf("invalid selector value: %q", value)
+	}
+	return nil
+}
+
+func (sel *Selector) addValue(key, value string, not bool) *Selector {
+	return sel.add(key, negate(value, not), validateSelectorValue(value))
+}
+
+func (sel *Selector) addNumber(key string, num float64) *Selector {
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return sel.add(key, "", fmt.Errorf("invalid number: %v", num))
+	}
+	return sel.add(key, formatNumber(num), nil)
+}
+
+func (sel *Selector) addRange(key string, r Range, integer bool) *Selector {
+	return sel.add(key, r.String(), r.validate(integer))
+}
+
+// Type limits the selector to entities of type, such as minecraft:zombie.
+func (sel *Selector) Type(entityType string) *Selector {
+	return sel.addValue("type", entityType, false)
+}
+
+// NotType excludes entities of type.
+func (sel *Selector) NotType(entityType string) *Selector {
+	return sel.addValue("type", entityType, true)
+}
+
+// Tag limits the selector to entities with tag. An empty tag matches entities
+// without any tags.
+func (sel *Selector) Tag(tag string) *Selector {
+	return sel.addValue("tag", tag, false)
+}
+
+// NotTag excludes entities with tag.
+func (sel *Selector) NotTag(tag string) *Selector {
+	return sel.addValue("tag", tag, true)
+}
+
+// Team limits the selector to members of team. An empty team matches
+// entities that are not on a team.
+func (sel *Selector) Team(team string) *Selector {
+	return sel.addValue("team", team, false)
+}
+
+// NotTeam excludes members of team.
+func (sel *Selector) NotTeam(team string) *Selector {
+	return sel.addValue("team", team, true)
+}
+
+// Name limits the selector to entities named name.
+func (sel *Selector) Name(name string) *Selector {
+	if validateSelectorValue(name) != nil {
+		name = client.Quote(name)
+	}
+	return sel.add("name", name, nil)
+}
+
+// Score limits the selector to entities whose score for objective is in r.
+func (sel *Selector) Score(objective string, r Range) *Selector {
+	if sel.err != nil {
+		return sel
+	}
+	if err := validateSelectorValue(objective); err != nil {
+		sel.err = err
+		return sel
+	}
+	if err := r.validate(true); err != nil {
+		sel.err = err
+		return sel
+	}
+	sel.scores = append(sel.scores, objective+"="+r.String())
+	return sel
+}
+
+// Distance limits the selector to entities within r blocks.
+func (sel *Selector) Distance(r Range) *Selector {
+	if r.HasMin && r.Min < 0 {
+		return sel.add("distance", "", fmt.Errorf("negative distance: %s", r))
+	}
+	return sel.addRange("distance", r, false)
+}
+
+// Level limits the selector to players with an experience level in r.
+func (sel *Selector) Level(r Range) *Selector {
+	return sel.addRange("level", r, true)
+}
+
+// X sets the x coordinate the selector is relative to.
+func (sel *Selector) X(x float64) *Selector {
+	return sel.addNumber("x", x)
+}
+
+// Y sets the y coordinate the selector is relative to.
+func (sel *Selector) Y(y float64) *Selector {
+	return sel.addNumber("y", y)
+}
+
+// Z sets the z coordinate the selector is relative to.
+func (sel *Selector) Z(z float64) *Selector {
+	return sel.addNumber("z", z)
+}
+
+// DX selects entities inside a volume dx blocks along the x axis.
+func (sel *Selector) DX(dx float64) *Selector {
+	return sel.addNumber("dx", dx)
+}
+
+// DY selects entities inside a volume dy blocks along the y axis.
+func (sel *Selector) DY(dy float64) *Selector {
+	return sel.addNumber("dy", dy)
+}
+
+// DZ selects entities inside a volume dz blocks along the z axis.
+func (sel *Selector) DZ(dz float64) *Selector {
+	return sel.addNumber("dz", dz)
+}
+
+// GameMode limits the selector to players in mode.
+func (sel *Selector) GameMode(mode GameMode) *Selector {
+	return sel.addValue("gamemode", string(mode), false)
+}
+
+// NotGameMode excludes players in mode.
+func (sel *Selector) NotGameMode(mode GameMode) *Selector {
+	return sel.addValue("gamemode", string(mode), true)
+}
+
+// NBT limits the selector to entities matching the SNBT compound data.
+func (sel *Selector) NBT(data string) *Selector {
+	tag, err := nbt.Parse(data)
+	if err != nil {
+		return sel.add("nbt", "", fmt.Errorf("invalid nbt: %q: %w", data, err))
+	}
+	compound, ok := tag.(nbt.Compound)
+	if !ok {
+		return sel.add("nbt", "", fmt.Errorf("invalid nbt: %q", data))
+	}
+	return sel.add("nbt", compound.String(), nil)
+}
+
+// Limit limits the number of entities selected.
+func (sel *Selector) Limit(limit int) *Selector {
+	if limit < 1 {
+		return sel.add("limit", "", fmt.Errorf("invalid limit: %d", limit))
+	}
+	return sel.add("limit", strconv.Itoa(limit), nil)
+}
+
+// Sort sets the order entities are selected in.
+func (sel *Selector) Sort(sort SelectorSort) *Selector {
+	return sel.addValue("sort", string(sort), false)
+}
+
+// CommandArgument renders the selector.
+func (sel *Selector) CommandArgument() (string, error) {
+	if sel.err != nil {
+		return "", sel.err
+	}
+	switch sel.base {
+	case SelectorNearestPlayer, SelectorAllPlayers, SelectorRandomPlayer,
+		SelectorSelf, SelectorAllEntities:
+	default:
+		return "", fmt.Errorf("invalid selector: %s", sel.base)
+	}
+	args := sel.args
+	if len(sel.scores) > 0 {
+		args = append(args[:len(args):len(args)],
+			"scores={"+strings.Join(sel.scores, ",")+"}")
+	}
+	if len(args) == 0 {
+		return string(sel.base), nil
+	}
+	return string(sel.base) + "[" + strings.Join(args, ",") + "]", nil
+}
+
+// String renders the selector, or an empty string if it is invalid.
+func (sel *Selector) String() string {
+	str, _ := sel.CommandArgument()
+	return str
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSelectorRender(t *testing.T) {
+	type testCase struct {
+		target   Target
+		expected string
+	}
+	testCases := []testCase{
+		{Player("Steve"), "Steve"},
+		{NewSelector(SelectorAllPlayers), "@a"},
+		{NewSelector(SelectorAllPlayers).Team("red").Distance(AtMost(10)).
+			Limit(3).Sort(SortNearest),
+			"@a[team=red,distance=..10,limit=3,sort=nearest]"},
+		{NewSelector(SelectorAllEntities).NotType("minecraft:player").
+			Tag("").NotTag("boss").Distance(Between(1.5, 10)),
+			"@e[type=!minecraft:player,tag=,tag=!boss,distance=1.5..10]"},
+		{NewSelector(SelectorAllPlayers).Score("kills", AtLeast(5)).
+			Score("deaths", Exactly(0)).GameMode(GameModeSurvival),
+			"@a[gamemode=survival,scores={kills=5..,deaths=0}]"},
+		{NewSelector(SelectorAllEntities).X(1).Y(-64).Z(2.5).DX(10).DY(5).
+			DZ(10), "@e[x=1,y=-64,z=2.5,dx=10,dy=5,dz=10]"},
+		{NewSelector(SelectorRandomPlayer).NotTeam("").NotGameMode(GameModeSpectator).
+			Level(Between(1, 30)), "@r[team=!,gamemode=!spectator,level=1..30]"},
+		{NewSelector(SelectorAllEntities).NBT(`{Tags:["a"]}`).Name("Big Bob"),
+			`@e[nbt={Tags:["a"]},name="Big Bob"]`},
+		{NewSelector(SelectorSelf).Name("Bob"), "@s[name=Bob]"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			str, err := tcase.target.CommandArgument()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+		})
+	}
+}
+
+func TestSelectorRenderFailures(t *testing.T) {
+	type testCase struct {
+		name   string
+		target Target
+		err    string
+	}
+	testCases := []testCase{
+		{"Player", Player("bad name"), "invalid user"},
+		{"Base", NewSelector("@x"), "invalid selector"},
+		{"Team", NewSelector(SelectorAllPlayers).Team("a,b"),
+			"invalid selector value"},
+		{"Limit", NewSelector(SelectorAllPlayers).Limit(0), "invalid limit"},
+		{"Distance", NewSelector(SelectorAllPlayers).Distance(AtLeast(-1)),
+			"negative distance"},
+		{"Backwards", NewSelector(SelectorAllPlayers).Distance(Between(5, 1)),
+			"invalid range"},
+		{"Empty", NewSelector(SelectorAllPlayers).Distance(Range{}),
+			"empty range"},
+		{"Score", NewSelector(SelectorAllPlayers).Score("kills", AtLeast(1.5)),
+			"invalid integer range"},
+		{"NBT", NewSelector(SelectorAllEntities).NBT("Tags"), "invalid nbt"},
+		{"NBTInjection", NewSelector(SelectorAllEntities).
+			NBT("{a:1},type=player,tag={}"), "invalid nbt"},
+		{"NBTUnbalanced", NewSelector(SelectorAllEntities).NBT(`{a:"}`),
+			"invalid nbt"},
+		{"NaN", NewSelector(SelectorAllEntities).X(math.NaN()),
+			"invalid number: NaN"},
+		{"Inf", NewSelector(SelectorAllEntities).DZ(math.Inf(1)),
+			"invalid number: +Inf"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := tcase.target.CommandArgument()
+			expectError(t, err, tcase.err)
+		})
+	}
+}
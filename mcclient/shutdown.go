@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+// Clock abstracts time so that scheduled operations can be tested.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// ShutdownOptions configures Shutdown.
+type ShutdownOptions struct {
+	// Delay is how long to wait before stopping the server.
+	Delay time.Duration
+	// Warnings are the times before the stop at which players are warned.
+	// Warnings longer than Delay are skipped.
+	Warnings []time.Duration
+	// Message is the warning shown to players. The remaining time replaces
+	// any %s, other text is shown as is. Defaults to
+	// "Server restarting in %s".
+	Message string
+	// Chat broadcasts warnings in chat.
+	Chat bool
+	// Title shows warnings as a title.
+	Title bool
+	// KickMessage, if set, kicks every player with this message before the
+	// final save.
+	KickMessage string
+	// CancelMessage, if set, is broadcast in chat if the shutdown is cancelled.
+	CancelMessage string
+	// Clock is used for scheduling. Defaults to the system clock.
+	Clock Clock
+}
+
+// formatRemaining formats a duration for players, for example "5 minutes".
+func formatRemaining(d time.Duration) string {
+	value, unit := int(d/time.Second), "second"
+	if d >= time.Minute && d%time.Minute == 0 {
+		value, unit = int(d/time.Minute), "minute"
+	}
+	if value != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s", value, unit)
+}
+
+// ignoreResponseError drops errors the server returned for a command, such as
+// no players being online, while keeping connection errors.
+func ignoreResponseError(err error) error {
+	var respErr *ResponseError
+	if errors.As(err, &respErr) {
+		return nil
+	}
+	return err
+}
+
+func (mc *MinecraftClient) warn(opts *ShutdownOptions,
+	remaining time.Duration) error {
+	message := strings.ReplaceAll(opts.Message, "%s",
+		formatRemaining(remaining))
+	if opts.Chat {
+		if err := mc.Say(message); err != nil {
+			return err
+		}
+	}
+	if opts.Title {
+		err := mc.Title(NewSelector(SelectorAllPlayers), Text(message))
+		if err := ignoreResponseError(err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown warns players of an upcoming stop, then kicks them, saves the
+// world and stops the server. Cancelling ctx before the stop aborts it.
+func (mc *MinecraftClient) Shutdown(ctx context.Context,
+	opts ShutdownOptions) error {
+	if opts.Delay < 0 {
+		return fmt.Errorf("invalid delay: %v", opts.Delay)
+	}
+	if len(opts.Message) == 0 {
+		opts.Message = "Server restarting in %s"
+	}
+	if opts.Clock == nil {
+		opts.Clock = realClock{}
+	}
+	warnings := append([]time.Duration{}, opts.Warnings...)
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i] > warnings[j]
+	})
+
+	deadline := opts.Clock.Now().Add(opts.Delay)
+	wait := func(until time.Time) error {
+		select {
+		case <-ctx.Done():
+		case <-opts.Clock.After(until.Sub(opts.Clock.Now())):
+		}
+		if err := ctx.Err(); err != nil {
+			if len(opts.CancelMessage) > 0 {
+				mc.Say(opts.CancelMessage)
+			}
+			return err
+		}
+		return nil
+	}
+	for i, warning := range warnings {
+		if warning > opts.Delay || warning <= 0 ||
+			(i > 0 && warning == warnings[i-1]) {
+			continue
+		}
+		if err := wait(deadline.Add(-warning)); err != nil {
+			return err
+		}
+		if err := mc.warn(&opts, warning); err != nil {
+			return err
+		}
+	}
+	if err := wait(deadline); err != nil {
+		return err
+	}
+
+	if len(opts.KickMessage) > 0 {
+		err := mc.Kick(NewSelector(SelectorAllPlayers), opts.KickMessage)
+		if err := ignoreResponseError(err); err != nil {
+			return err
+		}
+	}
+	if err := mc.SaveAll(true); err != nil {
+		return err
+	}
+	return mc.simpleRequest(client.NewCommand("stop"), "Stopping")
+}
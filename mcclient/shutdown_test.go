@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/Coderlane/go-minecraft-rcon/rcon"
+)
+
+// fakeClock fires every timer immediately, advancing its time to match.
+type fakeClock struct {
+	mtx sync.Mutex
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mtx.Lock()
+	defer clock.mtx.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mtx.Lock()
+	defer clock.mtx.Unlock()
+	clock.now = clock.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- clock.now
+	return ch
+}
+
+type shutdownServer struct {
+	srv    *rcon.Server
+	mc     *MinecraftClient
+	clock  *fakeClock
+	start  time.Time
+	mtx    sync.Mutex
+	events []string
+	onSay  func()
+}
+
+func newShutdownServer(t *testing.T) *shutdownServer {
+	save := rcon.MaxRequestsPerSecond
+	rcon.MaxRequestsPerSecond = 1000
+	t.Cleanup(func() {
+		rcon.MaxRequestsPerSecond = save
+	})
+	srv, err := rcon.Listen("", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss := &shutdownServer{
+		srv:   srv,
+		clock: &fakeClock{now: time.Unix(0, 0)},
+		start: time.Unix(0, 0),
+	}
+	record := func(resp string) rcon.HandlerFunc {
+		return func(cb rcon.ResponseCallback, cmd string) error {
+			ss.mtx.Lock()
+			ss.events = append(ss.events, fmt.Sprintf("%v %s",
+				ss.clock.Now().Sub(ss.start), cmd))
+			onSay := ss.onSay
+			ss.mtx.Unlock()
+			if onSay != nil && resp == "" {
+				onSay()
+			}
+			return cb(resp)
+		}
+	}
+	srv.HandleFunc("say", record(""))
+	srv.HandleFunc("title", record("Showing new title for test"))
+	srv.HandleFunc("kick", record("No player was found"))
+	srv.HandleFunc("save-all", record(saveAllResponse))
+	srv.HandleFunc("stop", record("Stopping the server"))
+
+	cl, err := client.NewClient(srv.Addr().String(), "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss.mc = NewMinecraftClient(cl)
+	return ss
+}
+
+func (ss *shutdownServer) Close() {
+	ss.mc.Close()
+	ss.srv.Close()
+}
+
+func TestShutdownSuccess(t *testing.T) {
+	ss := newShutdownServer(t)
+	defer ss.Close()
+
+	err := ss.mc.Shutdown(context.Background(), ShutdownOptions{
+		Delay:       5 * time.Minute,
+		Warnings:    []time.Duration{10 * time.Second, time.Minute, time.Hour},
+		Chat:        true,
+		Title:       true,
+		KickMessage: "Restarting",
+		Clock:       ss.clock,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"4m0s say Server restarting in 1 minute",
+		`4m0s title @a title {"text":"Server restarting in 1 minute"}`,
+		"4m50s say Server restarting in 10 seconds",
+		`4m50s title @a title {"text":"Server restarting in 10 seconds"}`,
+		"5m0s kick @a Restarting",
+		"5m0s save-all flush",
+		"5m0s stop",
+	}
+	if !reflect.DeepEqual(ss.events, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, ss.events)
+	}
+}
+
+func TestShutdownCancelled(t *testing.T) {
+	ss := newShutdownServer(t)
+	defer ss.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ss.onSay = cancel
+	err := ss.mc.Shutdown(ctx, ShutdownOptions{
+		Delay:         time.Minute,
+		Warnings:      []time.Duration{30 * time.Second, 10 * time.Second},
+		Message:       "Stopping in %s",
+		Chat:          true,
+		CancelMessage: "Cancelled",
+		Clock:         ss.clock,
+	})
+	if err != context.Canceled {
+		t.Fatalf("Expected context.Canceled: %v", err)
+	}
+	expected := []string{
+		"30s say Stopping in 30 seconds",
+		"50s say Cancelled",
+	}
+	if !reflect.DeepEqual(ss.events, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, ss.events)
+	}
+}
+
+func TestShutdownLiteralMessage(t *testing.T) {
+	ss := newShutdownServer(t)
+	defer ss.Close()
+
+	err := ss.mc.Shutdown(context.Background(), ShutdownOptions{
+		Delay:    time.Minute,
+		Warnings: []time.Duration{10 * time.Second},
+		Message:  "Restart soon! 100% safe",
+		Chat:     true,
+		Clock:    ss.clock,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"50s say Restart soon! 100% safe",
+		"1m0s save-all flush",
+		"1m0s stop",
+	}
+	if !reflect.DeepEqual(ss.events, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, ss.events)
+	}
+}
+
+func TestShutdownInvalid(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.Shutdown(context.Background(), ShutdownOptions{Delay: -1})
+	expectError(t, err, "invalid delay")
+}
+
+func TestFormatRemaining(t *testing.T) {
+	testCases := map[time.Duration]string{
+		time.Second:      "1 second",
+		30 * time.Second: "30 seconds",
+		time.Minute:      "1 minute",
+		90 * time.Second: "90 seconds",
+		5 * time.Minute:  "5 minutes",
+	}
+	for d, expected := range testCases {
+		if got := formatRemaining(d); got != expected {
+			t.Errorf("Expected: %s Got: %s", expected, got)
+		}
+	}
+}
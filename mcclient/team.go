@@ -0,0 +1,168 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	teamMembersRegex *regexp.Regexp = regexp.MustCompile(
+		`^Team \[.*\] has \d+ members?(?:\(s\))?: (.*)$`)
+)
+
+// Visibility controls who can see a team's name tags.
+type Visibility string
+
+const (
+	// VisibilityAlways shows name tags to everyone.
+	VisibilityAlways Visibility = "always"
+	// VisibilityNever hides name tags from everyone.
+	VisibilityNever Visibility = "never"
+	// VisibilityHideForOtherTeams only shows name tags to teammates.
+	VisibilityHideForOtherTeams Visibility = "hideForOtherTeams"
+	// VisibilityHideForOwnTeam hides name tags from teammates.
+	VisibilityHideForOwnTeam Visibility = "hideForOwnTeam"
+)
+
+func (visibility Visibility) validate() error {
+	switch visibility {
+	case VisibilityAlways, VisibilityNever, VisibilityHideForOtherTeams,
+		VisibilityHideForOwnTeam:
+		return nil
+	}
+	return fmt.Errorf("invalid visibility: %s", visibility)
+}
+
+func newTeamCommand(subcommand string) *client.Command {
+	return client.NewCommand("team").Literal(subcommand)
+}
+
+func newTeamModifyCommand(team, option string) *client.Command {
+	return newTeamCommand("modify").Literal(team).Literal(option)
+}
+
+// TeamAdd creates a team. If displayName is nil, the name is shown.
+func (mc *MinecraftClient) TeamAdd(team string,
+	displayName *TextComponent) error {
+	cmd := newTeamCommand("add").Literal(team)
+	if displayName != nil {
+		cmd.Arg(displayName)
+	}
+	return mc.simpleRequest(cmd, "Created team")
+}
+
+// TeamRemove removes a team.
+func (mc *MinecraftClient) TeamRemove(team string) error {
+	return mc.simpleRequest(newTeamCommand("remove").Literal(team),
+		"Removed team")
+}
+
+// TeamEmpty removes every member from a team.
+func (mc *MinecraftClient) TeamEmpty(team string) error {
+	return mc.simpleRequest(newTeamCommand("empty").Literal(team), "Removed")
+}
+
+// TeamJoin adds the members to a team.
+func (mc *MinecraftClient) TeamJoin(team string, members Target) error {
+	return mc.simpleRequest(newTeamCommand("join").Literal(team).Arg(members),
+		"Added")
+}
+
+// TeamLeave removes the members from whichever team they are on.
+func (mc *MinecraftClient) TeamLeave(members Target) error {
+	return mc.simpleRequest(newTeamCommand("leave").Arg(members), "Removed")
+}
+
+// TeamList returns the display names of every team. Display names are not
+// the team names that TeamMembers, TeamRemove and the other team methods
+// take, unless the team was created without a display name.
+func (mc *MinecraftClient) TeamList() (displayNames []string, err error) {
+	resp, err := mc.request(newTeamCommand("list"))
+	if err != nil {
+		return nil, err
+	}
+	return parseBracketedList(resp, "There are", "There are no teams")
+}
+
+// TeamMembers returns the names of the members of a team.
+func (mc *MinecraftClient) TeamMembers(team string) ([]string, error) {
+	resp, err := mc.request(newTeamCommand("list").Literal(team))
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(resp, "There are no members on team") {
+		return []string{}, nil
+	}
+	matches, err := parseResponse(resp, teamMembersRegex)
+	if err != nil {
+		return nil, err
+	}
+	members := strings.Split(matches[1], ",")
+	for i, member := range members {
+		members[i] = strings.TrimSpace(member)
+	}
+	return members, nil
+}
+
+// TeamSetDisplayName sets the name shown for a team.
+func (mc *MinecraftClient) TeamSetDisplayName(team string,
+	displayName TextComponent) error {
+	return mc.simpleRequest(newTeamModifyCommand(team, "displayName").
+		Arg(displayName), "Updated the name of team")
+}
+
+// TeamSetColor sets the color of the names of team members.
+func (mc *MinecraftClient) TeamSetColor(team string, color ChatColor) error {
+	if err := color.validate(); err != nil {
+		return err
+	}
+	return mc.simpleRequest(newTeamModifyCommand(team, "color").
+		Literal(string(color)), "Updated the color")
+}
+
+// TeamSetFriendlyFire sets whether team members can hurt each other.
+func (mc *MinecraftClient) TeamSetFriendlyFire(team string,
+	enabled bool) error {
+	prefix := "Disabled friendly fire"
+	if enabled {
+		prefix = "Enabled friendly fire"
+	}
+	return mc.simpleRequest(newTeamModifyCommand(team, "friendlyFire").
+		Bool(enabled), prefix)
+}
+
+// TeamSetSeeFriendlyInvisibles sets whether team members can see invisible
+// teammates.
+func (mc *MinecraftClient) TeamSetSeeFriendlyInvisibles(team string,
+	enabled bool) error {
+	return mc.simpleRequest(
+		newTeamModifyCommand(team, "seeFriendlyInvisibles").Bool(enabled),
+		"Team ")
+}
+
+// TeamSetNametagVisibility sets who can see the name tags of team members.
+func (mc *MinecraftClient) TeamSetNametagVisibility(team string,
+	visibility Visibility) error {
+	if err := visibility.validate(); err != nil {
+		return err
+	}
+	return mc.simpleRequest(newTeamModifyCommand(team, "nametagVisibility").
+		Literal(string(visibility)), "Nametag visibility")
+}
+
+// TeamSetPrefix sets the text shown before the names of team members.
+func (mc *MinecraftClient) TeamSetPrefix(team string,
+	prefix TextComponent) error {
+	return mc.simpleRequest(newTeamModifyCommand(team, "prefix").Arg(prefix),
+		"Team prefix set to")
+}
+
+// TeamSetSuffix sets the text shown after the names of team members.
+func (mc *MinecraftClient) TeamSetSuffix(team string,
+	suffix TextComponent) error {
+	return mc.simpleRequest(newTeamModifyCommand(team, "suffix").Arg(suffix),
+		"Team suffix set to")
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTeamAddRemoveSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`team add red {"text":"Red Team"}`).
+		Return("Created team [Red Team]", nil)
+	name := Text("Red Team")
+	if err := tc.mc.TeamAdd("red", &name); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team add red").
+		Return("A team already exists by that name", nil)
+	err := tc.mc.TeamAdd("red", nil)
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists: %v", err)
+	}
+	tc.client.EXPECT().Request("team remove red").
+		Return("Removed team [Red Team]", nil)
+	if err := tc.mc.TeamRemove("red"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team remove blue").
+		Return("Unknown team 'blue'", nil)
+	err = tc.mc.TeamRemove("blue")
+	if !errors.Is(err, ErrUnknownTeam) {
+		t.Errorf("Expected ErrUnknownTeam: %v", err)
+	}
+}
+
+func TestTeamMembershipSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("team join red @a[tag=red]").
+		Return("Added 3 entities to team [Red Team]", nil)
+	err := tc.mc.TeamJoin("red", NewSelector(SelectorAllPlayers).Tag("red"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team leave test").
+		Return("Removed test from any team", nil)
+	if err := tc.mc.TeamLeave(Player("test")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team empty red").
+		Return("Removed 2 member(s) from team [Red Team]", nil)
+	if err := tc.mc.TeamEmpty("red"); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team empty red").
+		Return("Nothing changed. That team is already empty", nil)
+	err = tc.mc.TeamEmpty("red")
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected ErrNothingChanged: %v", err)
+	}
+}
+
+func TestTeamListSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("team list").
+		Return("There are 2 team(s): [Red Team], [blue]]", nil)
+	teams, err := tc.mc.TeamList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Red Team", "blue]"}
+	if !reflect.DeepEqual(teams, expected) {
+		t.Errorf("Got: %v Expected: %v", teams, expected)
+	}
+	tc.client.EXPECT().Request("team list").
+		Return("There are no teams", nil)
+	teams, err = tc.mc.TeamList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 0 {
+		t.Errorf("Expected no teams: %v", teams)
+	}
+}
+
+func TestTeamMembersSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("team list red").
+		Return("Team [Red Team] has 2 member(s): test, other", nil)
+	members, err := tc.mc.TeamMembers("red")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"test", "other"}
+	if !reflect.DeepEqual(members, expected) {
+		t.Errorf("Got: %v Expected: %v", members, expected)
+	}
+	tc.client.EXPECT().Request("team list blue").
+		Return("Team [blue]] has 1 member(s): test", nil)
+	members, err = tc.mc.TeamMembers("blue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"test"}
+	if !reflect.DeepEqual(members, expected) {
+		t.Errorf("Got: %v Expected: %v", members, expected)
+	}
+	tc.client.EXPECT().Request("team list red").
+		Return("There are no members on team [Red Team]", nil)
+	members, err = tc.mc.TeamMembers("red")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 0 {
+		t.Errorf("Expected no members: %v", members)
+	}
+}
+
+func TestTeamModifySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("team modify red color red").
+		Return("Updated the color for team [Red Team] to red", nil)
+	if err := tc.mc.TeamSetColor("red", ColorRed); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team modify red friendlyFire false").
+		Return("Disabled friendly fire for team [Red Team]", nil)
+	if err := tc.mc.TeamSetFriendlyFire("red", false); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team modify red friendlyFire true").
+		Return("Enabled friendly fire for team [Red Team]", nil)
+	if err := tc.mc.TeamSetFriendlyFire("red", true); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("team modify red seeFriendlyInvisibles true").
+		Return("Team [Red Team] can now see invisible teammates", nil)
+	if err := tc.mc.TeamSetSeeFriendlyInvisibles("red", true); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(
+		"team modify red nametagVisibility hideForOtherTeams").
+		Return(`Nametag visibility for team [Red Team] is now "Hide for other teams"`, nil)
+	err := tc.mc.TeamSetNametagVisibility("red", VisibilityHideForOtherTeams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(
+		`team modify red prefix {"text":"[R] ","color":"red"}`).
+		Return("Team prefix set to [R] ", nil)
+	err = tc.mc.TeamSetPrefix("red", TextComponent{Text: "[R] ", Color: "red"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`team modify red suffix {"text":" *"}`).
+		Return("Team suffix set to  *", nil)
+	if err := tc.mc.TeamSetSuffix("red", Text(" *")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`team modify red displayName {"text":"Reds"}`).
+		Return("Updated the name of team [Reds]", nil)
+	if err := tc.mc.TeamSetDisplayName("red", Text("Reds")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTeamModifyErrors(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("team modify red color red").
+		Return("Nothing changed. That team already has that color", nil)
+	err := tc.mc.TeamSetColor("red", ColorRed)
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected ErrNothingChanged: %v", err)
+	}
+	err = tc.mc.TeamSetColor("red", "pink")
+	expectError(t, err, "invalid color: pink")
+	err = tc.mc.TeamSetNametagVisibility("red", "sometimes")
+	expectError(t, err, "invalid visibility: sometimes")
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+// TeleportToEntity teleports the targets to another entity.
+func (mc *MinecraftClient) TeleportToEntity(targets Target,
+	destination Target) error {
+	return mc.simpleRequest(client.NewCommand("tp").Arg(targets).
+		Arg(destination), "Teleported")
+}
+
+// TeleportToPosition teleports the targets to a position. If rotation is set,
+// the targets are also turned to face that way.
+func (mc *MinecraftClient) TeleportToPosition(targets Target, pos Vec3,
+	rotation *Rotation) error {
+	cmd := client.NewCommand("tp").Arg(targets).Arg(pos)
+	if rotation != nil {
+		cmd.Arg(rotation)
+	}
+	return mc.simpleRequest(cmd, "Teleported")
+}
+
+// TeleportFacing teleports the targets to a position, facing another
+// position.
+func (mc *MinecraftClient) TeleportFacing(targets Target, pos Vec3,
+	facing Vec3) error {
+	return mc.simpleRequest(client.NewCommand("tp").Arg(targets).Arg(pos).
+		Literal("facing").Arg(facing), "Teleported")
+}
+
+// TeleportFacingEntity teleports the targets to a position, facing an entity.
+func (mc *MinecraftClient) TeleportFacingEntity(targets Target, pos Vec3,
+	facing Target) error {
+	return mc.simpleRequest(client.NewCommand("tp").Arg(targets).Arg(pos).
+		Literal("facing").Literal("entity").Arg(facing), "Teleported")
+}
+
+// SetSpawnpoint sets where the targeted players respawn, facing angle.
+func (mc *MinecraftClient) SetSpawnpoint(targets Target, pos Vec3,
+	angle float64) error {
+	return mc.simpleRequest(client.NewCommand("spawnpoint").Arg(targets).
+		Arg(blockPos(pos)).Float(angle), "Set spawn point")
+}
+
+// SetWorldSpawn sets where new players spawn, facing angle.
+func (mc *MinecraftClient) SetWorldSpawn(pos Vec3, angle float64) error {
+	return mc.simpleRequest(client.NewCommand("setworldspawn").
+		Arg(blockPos(pos)).Float(angle), "Set the world spawn point")
+}
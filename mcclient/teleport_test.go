@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTeleportSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("tp test other").
+		Return("Teleported test to other", nil)
+	if err := tc.mc.TeleportToEntity(Player("test"), Player("other")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("tp @a[team=red] 0.5 64 0.5 90 0").
+		Return("Teleported 3 entities to 0.500000, 64.000000, 0.500000", nil)
+	err := tc.mc.TeleportToPosition(NewSelector(SelectorAllPlayers).Team("red"),
+		Pos(0.5, 64, 0.5), &Rotation{Abs(90), Abs(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("tp test ~ ~10 ~").
+		Return("Teleported test to 1.0, 74.0, 2.0", nil)
+	err = tc.mc.TeleportToPosition(Player("test"),
+		Vec3{Rel(0), Rel(10), Rel(0)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTeleportFacingSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("tp test 0 64 0 facing 10 64 10").
+		Return("Teleported test to 0.0, 64.0, 0.0", nil)
+	err := tc.mc.TeleportFacing(Player("test"), Pos(0, 64, 0), Pos(10, 64, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("tp test 0 64 0 facing entity other").
+		Return("Teleported test to 0.0, 64.0, 0.0", nil)
+	err = tc.mc.TeleportFacingEntity(Player("test"), Pos(0, 64, 0),
+		Player("other"))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTeleportNoEntity(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("tp test other").
+		Return("No entity was found", nil)
+	err := tc.mc.TeleportToEntity(Player("test"), Player("other"))
+	if !errors.Is(err, ErrNoEntity) {
+		t.Errorf("Expected ErrNoEntity: %v", err)
+	}
+}
+
+func TestSetSpawnSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("spawnpoint test 10 64 -20 90").
+		Return("Set spawn point to 10, 64, -20 [90.0] in minecraft:overworld "+
+			"for test", nil)
+	err := tc.mc.SetSpawnpoint(Player("test"), Pos(10, 64, -20), 90)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("setworldspawn 0 70 0 0").
+		Return("Set the world spawn point to 0, 70, 0 [0.0]", nil)
+	if err := tc.mc.SetWorldSpawn(Pos(0, 70, 0), 0); err != nil {
+		t.Fatal(err)
+	}
+	err = tc.mc.SetWorldSpawn(Pos(0.5, 70, 0), 0)
+	expectError(t, err, "invalid block position: 0.5")
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ChatColor is one of the named colors used for text and teams.
+type ChatColor string
+
+// The named chat colors.
+const (
+	ColorBlack       ChatColor = "black"
+	ColorDarkBlue    ChatColor = "dark_blue"
+	ColorDarkGreen   ChatColor = "dark_green"
+	ColorDarkAqua    ChatColor = "dark_aqua"
+	ColorDarkRed     ChatColor = "dark_red"
+	ColorDarkPurple  ChatColor = "dark_purple"
+	ColorGold        ChatColor = "gold"
+	ColorGray        ChatColor = "gray"
+	ColorDarkGray    ChatColor = "dark_gray"
+	ColorBlue        ChatColor = "blue"
+	ColorGreen       ChatColor = "green"
+	ColorAqua        ChatColor = "aqua"
+	ColorRed         ChatColor = "red"
+	ColorLightPurple ChatColor = "light_purple"
+	ColorYellow      ChatColor = "yellow"
+	ColorWhite       ChatColor = "white"
+	// ColorReset removes any color.
+	ColorReset ChatColor = "reset"
+)
+
+func (color ChatColor) validate() error {
+	switch color {
+	case ColorBlack, ColorDarkBlue, ColorDarkGreen, ColorDarkAqua, ColorDarkRed,
+		ColorDarkPurple, ColorGold, ColorGray, ColorDarkGray, ColorBlue,
+		ColorGreen, ColorAqua, ColorRed, ColorLightPurple, ColorYellow,
+		ColorWhite, ColorReset:
+		return nil
+	}
+	return fmt.Errorf("invalid color: %s", color)
+}
+
+// TextComponent is a JSON text component, used by commands such as title and
+// tellraw to display formatted text.
+type TextComponent struct {
+	Text string `json:"text"`
+	// Color is either a ChatColor or a hex color such as #ff0000.
+	Color         string          `json:"color,omitempty"`
+	Bold          bool            `json:"bold,omitempty"`
+	Italic        bool            `json:"italic,omitempty"`
+	Underlined    bool            `json:"underlined,omitempty"`
+	Strikethrough bool            `json:"strikethrough,omitempty"`
+	Obfuscated    bool            `json:"obfuscated,omitempty"`
+	Extra         []TextComponent `json:"extra,omitempty"`
+}
+
+// Text creates a plain text component.
+func Text(text string) TextComponent {
+	return TextComponent{
+		Text: text,
+	}
+}
+
+// CommandArgument renders the component as JSON.
+func (text TextComponent) CommandArgument() (string, error) {
+	data, err := json.Marshal(text)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestTextComponentRender(t *testing.T) {
+	type testCase struct {
+		text     TextComponent
+		expected string
+	}
+	testCases := []testCase{
+		{Text("hi"), `{"text":"hi"}`},
+		{Text(`say "hi"`), `{"text":"say \"hi\""}`},
+		{TextComponent{Text: "Red", Color: "red", Bold: true,
+			Extra: []TextComponent{Text(" team")}},
+			`{"text":"Red","color":"red","bold":true,"extra":[{"text":" team"}]}`},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			str, err := tcase.text.CommandArgument()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+		})
+	}
+}
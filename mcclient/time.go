@@ -0,0 +1,136 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+// TickDuration is the length of a single game tick.
+const TickDuration time.Duration = 50 * time.Millisecond
+
+var (
+	timeSetRegex   *regexp.Regexp = regexp.MustCompile(`^Set the time to (\d+)`)
+	timeQueryRegex *regexp.Regexp = regexp.MustCompile(`^The time is (\d+)`)
+)
+
+// TimeOfDay is a named time that the clock can be set to.
+type TimeOfDay string
+
+const (
+	// TimeDay is 1000 ticks.
+	TimeDay TimeOfDay = "day"
+	// TimeNoon is 6000 ticks.
+	TimeNoon TimeOfDay = "noon"
+	// TimeNight is 13000 ticks.
+	TimeNight TimeOfDay = "night"
+	// TimeMidnight is 18000 ticks.
+	TimeMidnight TimeOfDay = "midnight"
+)
+
+// TimeQueryType selects which clock TimeQuery reads.
+type TimeQueryType string
+
+const (
+	// TimeQueryDaytime is the time of day in ticks.
+	TimeQueryDaytime TimeQueryType = "daytime"
+	// TimeQueryGametime is the total number of ticks the world has run.
+	TimeQueryGametime TimeQueryType = "gametime"
+	// TimeQueryDay is the number of in game days that have passed.
+	TimeQueryDay TimeQueryType = "day"
+)
+
+// Weather is the weather in the world.
+type Weather string
+
+const (
+	// WeatherClear stops any rain.
+	WeatherClear Weather = "clear"
+	// WeatherRain starts rain or snow.
+	WeatherRain Weather = "rain"
+	// WeatherThunder starts a thunderstorm.
+	WeatherThunder Weather = "thunder"
+)
+
+// durationTicks converts d into a whole number of ticks.
+func durationTicks(d time.Duration) int {
+	return int(d / TickDuration)
+}
+
+// TimeSet sets the time to a named time of day and returns the new time.
+func (mc *MinecraftClient) TimeSet(timeOfDay TimeOfDay) (int, error) {
+	switch timeOfDay {
+	case TimeDay, TimeNoon, TimeNight, TimeMidnight:
+	default:
+		return 0, fmt.Errorf("invalid time of day: %s", timeOfDay)
+	}
+	resp, err := mc.request(client.NewCommand("time").Literal("set").
+		Literal(string(timeOfDay)))
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, timeSetRegex)
+}
+
+// TimeSetTicks sets the time to ticks and returns the new time.
+func (mc *MinecraftClient) TimeSetTicks(ticks int) (int, error) {
+	if ticks < 0 {
+		return 0, fmt.Errorf("invalid time: %d", ticks)
+	}
+	resp, err := mc.request(client.NewCommand("time").Literal("set").
+		Int(ticks))
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, timeSetRegex)
+}
+
+// TimeAdd advances the time by ticks and returns the new time.
+func (mc *MinecraftClient) TimeAdd(ticks int) (int, error) {
+	if ticks < 0 {
+		return 0, fmt.Errorf("invalid time: %d", ticks)
+	}
+	resp, err := mc.request(client.NewCommand("time").Literal("add").
+		Int(ticks))
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, timeSetRegex)
+}
+
+// TimeQuery reads one of the world's clocks.
+func (mc *MinecraftClient) TimeQuery(query TimeQueryType) (int, error) {
+	switch query {
+	case TimeQueryDaytime, TimeQueryGametime, TimeQueryDay:
+	default:
+		return 0, fmt.Errorf("invalid time query: %s", query)
+	}
+	resp, err := mc.request(client.NewCommand("time").Literal("query").
+		Literal(string(query)))
+	if err != nil {
+		return 0, err
+	}
+	return parseResponseInt(resp, timeQueryRegex)
+}
+
+// Weather sets the weather for duration, which is rounded down to whole ticks.
+// A zero duration lets the server pick a random duration.
+func (mc *MinecraftClient) Weather(weather Weather,
+	duration time.Duration) error {
+	switch weather {
+	case WeatherClear, WeatherRain, WeatherThunder:
+	default:
+		return fmt.Errorf("invalid weather: %s", weather)
+	}
+	cmd := client.NewCommand("weather").Literal(string(weather))
+	if duration != 0 {
+		ticks := durationTicks(duration)
+		if ticks < 1 {
+			return fmt.Errorf("invalid duration: %v", duration)
+		}
+		cmd.Int(ticks)
+	}
+	return mc.simpleRequest(cmd, "Set the weather to")
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("time set night").
+		Return("Set the time to 13000", nil)
+	ticks, err := tc.mc.TimeSet(TimeNight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticks != 13000 {
+		t.Errorf("Expected: 13000 Got: %d", ticks)
+	}
+
+	tc.client.EXPECT().Request("time set 500").
+		Return("Set the time to 500", nil)
+	ticks, err = tc.mc.TimeSetTicks(500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticks != 500 {
+		t.Errorf("Expected: 500 Got: %d", ticks)
+	}
+}
+
+func TestTimeSetInvalid(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	_, err := tc.mc.TimeSet("dusk")
+	expectError(t, err, "invalid time of day: dusk")
+	_, err = tc.mc.TimeSetTicks(-1)
+	expectError(t, err, "invalid time: -1")
+	_, err = tc.mc.TimeAdd(-1)
+	expectError(t, err, "invalid time: -1")
+}
+
+func TestTimeAddSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("time add 100").
+		Return("Set the time to 1100", nil)
+	ticks, err := tc.mc.TimeAdd(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticks != 1100 {
+		t.Errorf("Expected: 1100 Got: %d", ticks)
+	}
+}
+
+func TestTimeQuerySuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("time query gametime").
+		Return("The time is 123456", nil)
+	ticks, err := tc.mc.TimeQuery(TimeQueryGametime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticks != 123456 {
+		t.Errorf("Expected: 123456 Got: %d", ticks)
+	}
+}
+
+func TestTimeQueryErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("time query day").
+		Return("Unknown or incomplete command, see below for error", nil)
+	_, err := tc.mc.TimeQuery(TimeQueryDay)
+	if !errors.Is(err, ErrUnknownCommand) {
+		t.Errorf("Expected ErrUnknownCommand: %v", err)
+	}
+	_, err = tc.mc.TimeQuery("week")
+	expectError(t, err, "invalid time query: week")
+}
+
+func TestWeatherSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("weather thunder 1200").
+		Return("Set the weather to rain & thunder", nil)
+	if err := tc.mc.Weather(WeatherThunder, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("weather clear").
+		Return("Set the weather to clear", nil)
+	if err := tc.mc.Weather(WeatherClear, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWeatherInvalid(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	err := tc.mc.Weather("snow", 0)
+	expectError(t, err, "invalid weather: snow")
+	err = tc.mc.Weather(WeatherRain, -time.Second)
+	expectError(t, err, "invalid duration")
+	err = tc.mc.Weather(WeatherRain, 10*time.Millisecond)
+	expectError(t, err, "invalid duration: 10ms")
+}
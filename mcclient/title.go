@@ -0,0 +1,56 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+func newTitleCommand(target Target, subcommand string) *client.Command {
+	return client.NewCommand("title").Arg(target).Literal(subcommand)
+}
+
+// Title shows a title in the middle of the screen of the targeted players.
+func (mc *MinecraftClient) Title(target Target, title TextComponent) error {
+	return mc.simpleRequest(newTitleCommand(target, "title").Arg(title),
+		"Showing new title")
+}
+
+// Subtitle sets the text shown below the next title of the targeted players.
+func (mc *MinecraftClient) Subtitle(target Target,
+	subtitle TextComponent) error {
+	return mc.simpleRequest(newTitleCommand(target, "subtitle").Arg(subtitle),
+		"Showing new subtitle")
+}
+
+// ActionBar shows text above the hotbar of the targeted players.
+func (mc *MinecraftClient) ActionBar(target Target, text TextComponent) error {
+	return mc.simpleRequest(newTitleCommand(target, "actionbar").Arg(text),
+		"Showing new actionbar title")
+}
+
+// TitleTimes sets how long titles take to fade in, stay on screen and fade
+// out for the targeted players. Durations are rounded down to whole ticks.
+func (mc *MinecraftClient) TitleTimes(target Target, fadeIn, stay,
+	fadeOut time.Duration) error {
+	if fadeIn < 0 || stay < 0 || fadeOut < 0 {
+		return fmt.Errorf("invalid title times: %v %v %v", fadeIn, stay, fadeOut)
+	}
+	return mc.simpleRequest(newTitleCommand(target, "times").
+		Int(durationTicks(fadeIn)).Int(durationTicks(stay)).
+		Int(durationTicks(fadeOut)), "Changing title display times")
+}
+
+// TitleClear removes the current title from the screens of the targeted
+// players.
+func (mc *MinecraftClient) TitleClear(target Target) error {
+	return mc.simpleRequest(newTitleCommand(target, "clear"), "Cleared titles")
+}
+
+// TitleReset clears the title and resets the subtitle and times of the
+// targeted players.
+func (mc *MinecraftClient) TitleReset(target Target) error {
+	return mc.simpleRequest(newTitleCommand(target, "reset"),
+		"Reset title options")
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTitleSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`title @a title {"text":"Round 1","bold":true}`).
+		Return("Showing new title for 3 players", nil)
+	err := tc.mc.Title(NewSelector(SelectorAllPlayers),
+		TextComponent{Text: "Round 1", Bold: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`title test subtitle {"text":"Fight!"}`).
+		Return("Showing new subtitle for test", nil)
+	if err := tc.mc.Subtitle(Player("test"), Text("Fight!")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request(`title test actionbar {"text":"10s left"}`).
+		Return("Showing new actionbar title for test", nil)
+	if err := tc.mc.ActionBar(Player("test"), Text("10s left")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTitleTimesSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("title test times 10 70 20").
+		Return("Changing title display times for test", nil)
+	err := tc.mc.TitleTimes(Player("test"), 500*time.Millisecond,
+		3500*time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tc.mc.TitleTimes(Player("test"), -time.Second, 0, 0)
+	expectError(t, err, "invalid title times")
+}
+
+func TestTitleClearResetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("title test clear").
+		Return("Cleared titles for test", nil)
+	if err := tc.mc.TitleClear(Player("test")); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("title @a reset").
+		Return("Reset title options for 2 players", nil)
+	if err := tc.mc.TitleReset(NewSelector(SelectorAllPlayers)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTitleErrorReturned(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request(`title @a title {"text":"hi"}`).
+		Return("No player was found", nil)
+	err := tc.mc.Title(NewSelector(SelectorAllPlayers), Text("hi"))
+	expectError(t, err, "No player was found")
+}
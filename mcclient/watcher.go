@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+// PlayerEventType is the kind of change a PlayerEvent reports.
+type PlayerEventType int
+
+const (
+	// PlayerJoined is reported when a player first appears in the list.
+	PlayerJoined PlayerEventType = iota
+	// PlayerLeft is reported when a player is no longer in the list.
+	PlayerLeft
+)
+
+// String returns the name of the event type.
+func (eventType PlayerEventType) String() string {
+	if eventType == PlayerJoined {
+		return "joined"
+	}
+	return "left"
+}
+
+// PlayerEvent is emitted by WatchPlayers when a player joins or leaves.
+type PlayerEvent struct {
+	Type   PlayerEventType
+	Player string
+	// Time is when the change was first observed.
+	Time time.Time
+	// Session is how long the player was online. Only set when they leave.
+	Session time.Duration
+}
+
+// WatchOptions configures WatchPlayers.
+type WatchOptions struct {
+	// Interval is how often the player list is polled. Defaults to 5 seconds.
+	Interval time.Duration
+	// LeaveGrace is how long a player has to be missing before they are
+	// reported as having left. Players that come back within the grace period,
+	// for example after a quick server restart, keep their session. Defaults to
+	// twice Interval; a negative grace reports players as soon as they are
+	// missing.
+	LeaveGrace time.Duration
+	// Dial, if set, is used to open a new connection after a poll fails.
+	Dial func() (client.Client, error)
+	// OnError, if set, is called with every error encountered while polling.
+	OnError func(error)
+	// Clock is used for scheduling. Defaults to the system clock.
+	Clock Clock
+}
+
+type playerSession struct {
+	joined       time.Time
+	missingSince time.Time
+}
+
+type playerWatcher struct {
+	opts   WatchOptions
+	mc     *MinecraftClient
+	dialed client.Client
+	online map[string]*playerSession
+	events chan PlayerEvent
+}
+
+// WatchPlayers polls the player list and reports players joining and leaving
+// on the returned channel. Players online during the first poll are reported
+// as having joined. The channel is closed once ctx is done.
+//
+// Failed polls are ignored, so players are not reported as leaving while the
+// server is unreachable.
+func (mc *MinecraftClient) WatchPlayers(ctx context.Context,
+	opts WatchOptions) <-chan PlayerEvent {
+	if opts.Interval <= 0 {
+		opts.Interval = 5 * time.Second
+	}
+	if opts.LeaveGrace == 0 {
+		opts.LeaveGrace = 2 * opts.Interval
+	}
+	if opts.Clock == nil {
+		opts.Clock = realClock{}
+	}
+	watcher := &playerWatcher{
+		opts:   opts,
+		mc:     mc,
+		online: make(map[string]*playerSession),
+		events: make(chan PlayerEvent),
+	}
+	go watcher.run(ctx)
+	return watcher.events
+}
+
+func (watcher *playerWatcher) run(ctx context.Context) {
+	defer close(watcher.events)
+	defer func() {
+		if watcher.dialed != nil {
+			watcher.dialed.Close()
+		}
+	}()
+	for {
+		if !watcher.poll(ctx) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-watcher.opts.Clock.After(watcher.opts.Interval):
+		}
+	}
+}
+
+func (watcher *playerWatcher) reportError(err error) {
+	if watcher.opts.OnError != nil {
+		watcher.opts.OnError(err)
+	}
+}
+
+func (watcher *playerWatcher) reconnect() {
+	if watcher.opts.Dial == nil {
+		return
+	}
+	if watcher.dialed != nil {
+		watcher.dialed.Close()
+		watcher.dialed = nil
+	}
+	conn, err := watcher.opts.Dial()
+	if err != nil {
+		watcher.reportError(err)
+		return
+	}
+	watcher.dialed = conn
+	watcher.mc = NewMinecraftClient(conn)
+}
+
+func (watcher *playerWatcher) emit(ctx context.Context,
+	event PlayerEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case watcher.events <- event:
+		return true
+	}
+}
+
+// poll updates the online players and emits events. It returns false once
+// ctx is done.
+func (watcher *playerWatcher) poll(ctx context.Context) bool {
+	users, err := watcher.mc.UsersList()
+	if err != nil {
+		watcher.reportError(err)
+		watcher.reconnect()
+		return ctx.Err() == nil
+	}
+	now := watcher.opts.Clock.Now()
+	present := make(map[string]bool)
+	for _, user := range users {
+		present[user] = true
+		session, ok := watcher.online[user]
+		if ok {
+			session.missingSince = time.Time{}
+			continue
+		}
+		watcher.online[user] = &playerSession{
+			joined: now,
+		}
+		if !watcher.emit(ctx, PlayerEvent{
+			Type:   PlayerJoined,
+			Player: user,
+			Time:   now,
+		}) {
+			return false
+		}
+	}
+	left := []string{}
+	for user := range watcher.online {
+		if !present[user] {
+			left = append(left, user)
+		}
+	}
+	sort.Strings(left)
+	for _, user := range left {
+		session := watcher.online[user]
+		if session.missingSince.IsZero() {
+			session.missingSince = now
+		}
+		if now.Sub(session.missingSince) < watcher.opts.LeaveGrace {
+			continue
+		}
+		delete(watcher.online, user)
+		if !watcher.emit(ctx, PlayerEvent{
+			Type:    PlayerLeft,
+			Player:  user,
+			Time:    session.missingSince,
+			Session: session.missingSince.Sub(session.joined),
+		}) {
+			return false
+		}
+	}
+	return ctx.Err() == nil
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+	"github.com/golang/mock/gomock"
+)
+
+// expectPolls scripts the responses to list, cancelling ctx once they run
+// out. An empty response fails the poll.
+func (tc *testContext) expectPolls(cancel func(), responses ...string) {
+	polls := 0
+	tc.client.EXPECT().Request("list").AnyTimes().
+		DoAndReturn(func(cmd string) (string, error) {
+			if polls == len(responses) {
+				cancel()
+				return "", fmt.Errorf("done")
+			}
+			resp := responses[polls]
+			polls++
+			if len(resp) == 0 {
+				return "", fmt.Errorf("connection reset")
+			}
+			return resp, nil
+		})
+}
+
+func collectEvents(events <-chan PlayerEvent) []string {
+	out := []string{}
+	for event := range events {
+		out = append(out, fmt.Sprintf("%v %s %s %v", event.Time.Unix(),
+			event.Player, event.Type, event.Session))
+	}
+	return out
+}
+
+func TestWatchPlayersJoinLeave(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tc.expectPolls(cancel,
+		"There are 1 of a max of 20 players online: alice",
+		"There are 2 of a max of 20 players online: alice, bob",
+		"There are 1 of a max of 20 players online: bob",
+		"There are 0 of a max of 20 players online:",
+	)
+	events := tc.mc.WatchPlayers(ctx, WatchOptions{
+		Interval:   10 * time.Second,
+		LeaveGrace: -1,
+		Clock:      &fakeClock{now: time.Unix(0, 0)},
+	})
+	expected := []string{
+		"0 alice joined 0s",
+		"10 bob joined 0s",
+		"20 alice left 20s",
+		"30 bob left 20s",
+	}
+	if got := collectEvents(events); !reflect.DeepEqual(got, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, got)
+	}
+}
+
+func TestWatchPlayersRestart(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tc.expectPolls(cancel,
+		"There are 2 of a max of 20 players online: alice, bob",
+		"",
+		"",
+		"There are 0 of a max of 20 players online:",
+		"There are 1 of a max of 20 players online: alice",
+		"There are 1 of a max of 20 players online: alice",
+		"There are 1 of a max of 20 players online: alice",
+	)
+	errors := 0
+	events := tc.mc.WatchPlayers(ctx, WatchOptions{
+		Interval:   10 * time.Second,
+		LeaveGrace: 20 * time.Second,
+		OnError: func(err error) {
+			errors++
+		},
+		Clock: &fakeClock{now: time.Unix(0, 0)},
+	})
+	expected := []string{
+		"0 alice joined 0s",
+		"0 bob joined 0s",
+		"30 bob left 30s",
+	}
+	if got := collectEvents(events); !reflect.DeepEqual(got, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, got)
+	}
+	if errors != 3 {
+		t.Errorf("Expected 3 errors, got: %d", errors)
+	}
+}
+
+func TestWatchPlayersRestartDefaultGrace(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tc.expectPolls(cancel,
+		"There are 2 of a max of 20 players online: alice, bob",
+		"",
+		"There are 0 of a max of 20 players online:",
+		"There are 1 of a max of 20 players online: alice",
+		"There are 1 of a max of 20 players online: alice",
+		"There are 1 of a max of 20 players online: alice",
+	)
+	events := tc.mc.WatchPlayers(ctx, WatchOptions{
+		Interval: 10 * time.Second,
+		Clock:    &fakeClock{now: time.Unix(0, 0)},
+	})
+	expected := []string{
+		"0 alice joined 0s",
+		"0 bob joined 0s",
+		"20 bob left 20s",
+	}
+	if got := collectEvents(events); !reflect.DeepEqual(got, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, got)
+	}
+}
+
+func TestWatchPlayersReconnect(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tc.client.EXPECT().Request("list").
+		Return("", fmt.Errorf("connection reset"))
+	dialed := client.NewMockClient(tc.ctrl)
+	gomock.InOrder(
+		dialed.EXPECT().Request("list").
+			Return("There are 1 of a max of 20 players online: alice", nil),
+		dialed.EXPECT().Request("list").
+			DoAndReturn(func(cmd string) (string, error) {
+				cancel()
+				return "There are 1 of a max of 20 players online: alice", nil
+			}),
+		dialed.EXPECT().Close().Return(nil),
+	)
+	events := tc.mc.WatchPlayers(ctx, WatchOptions{
+		Dial: func() (client.Client, error) {
+			return dialed, nil
+		},
+		Clock: &fakeClock{now: time.Unix(0, 0)},
+	})
+	expected := []string{
+		"5 alice joined 0s",
+	}
+	if got := collectEvents(events); !reflect.DeepEqual(got, expected) {
+		t.Errorf("\nExpected: %q\nGot:      %q", expected, got)
+	}
+}
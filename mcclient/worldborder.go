@@ -0,0 +1,121 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Coderlane/go-minecraft-rcon/client"
+)
+
+var (
+	worldBorderGetRegex *regexp.Regexp = regexp.MustCompile(
+		`^The world border is currently (-?[\d.]+) block`)
+)
+
+func newWorldBorderCommand(subcommand string) *client.Command {
+	return client.NewCommand("worldborder").Literal(subcommand)
+}
+
+// WorldBorderGet returns the current width of the world border.
+func (mc *MinecraftClient) WorldBorderGet() (float64, error) {
+	resp, err := mc.request(newWorldBorderCommand("get"))
+	if err != nil {
+		return 0, err
+	}
+	matches, err := parseResponse(resp, worldBorderGetRegex)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(matches[1], 64)
+}
+
+// resizeWorldBorder runs set or add, moving the border over duration, which
+// is rounded down to whole seconds.
+func (mc *MinecraftClient) resizeWorldBorder(cmd *client.Command,
+	duration time.Duration) error {
+	if duration < 0 {
+		return fmt.Errorf("invalid duration: %v", duration)
+	}
+	if seconds := int(duration / time.Second); seconds > 0 {
+		cmd.Int(seconds)
+	}
+	resp, err := mc.request(cmd)
+	if err != nil {
+		return err
+	}
+	for _, prefix := range []string{"Set the world border", "Growing",
+		"Shrinking"} {
+		if strings.HasPrefix(resp, prefix) {
+			return nil
+		}
+	}
+	return newResponseError(resp)
+}
+
+// WorldBorderSet sets the width of the world border, growing or shrinking it
+// over duration.
+func (mc *MinecraftClient) WorldBorderSet(width float64,
+	duration time.Duration) error {
+	if width < 1 {
+		return fmt.Errorf("invalid width: %v", width)
+	}
+	return mc.resizeWorldBorder(newWorldBorderCommand("set").Float(width),
+		duration)
+}
+
+// WorldBorderAdd changes the width of the world border by delta, growing or
+// shrinking it over duration.
+func (mc *MinecraftClient) WorldBorderAdd(delta float64,
+	duration time.Duration) error {
+	return mc.resizeWorldBorder(newWorldBorderCommand("add").Float(delta),
+		duration)
+}
+
+// WorldBorderCenter moves the center of the world border.
+func (mc *MinecraftClient) WorldBorderCenter(x, z float64) error {
+	return mc.simpleRequest(newWorldBorderCommand("center").Float(x).Float(z),
+		"Set the center of the world border")
+}
+
+// WorldBorderDamage sets the damage per second players take for each block
+// they are past the damage buffer.
+func (mc *MinecraftClient) WorldBorderDamage(damage float64) error {
+	if damage < 0 {
+		return fmt.Errorf("invalid damage: %v", damage)
+	}
+	return mc.simpleRequest(newWorldBorderCommand("damage").Literal("amount").
+		Float(damage), "Set the world border damage to")
+}
+
+// WorldBorderDamageBuffer sets how far past the border players can go before
+// they take damage.
+func (mc *MinecraftClient) WorldBorderDamageBuffer(distance float64) error {
+	if distance < 0 {
+		return fmt.Errorf("invalid distance: %v", distance)
+	}
+	return mc.simpleRequest(newWorldBorderCommand("damage").Literal("buffer").
+		Float(distance), "Set the world border damage buffer")
+}
+
+// WorldBorderWarningDistance sets how close to the border players are warned.
+func (mc *MinecraftClient) WorldBorderWarningDistance(distance int) error {
+	if distance < 0 {
+		return fmt.Errorf("invalid distance: %v", distance)
+	}
+	return mc.simpleRequest(newWorldBorderCommand("warning").
+		Literal("distance").Int(distance),
+		"Set the world border warning distance")
+}
+
+// WorldBorderWarningTime sets how long before a shrinking border reaches
+// players they are warned, rounded down to whole seconds.
+func (mc *MinecraftClient) WorldBorderWarningTime(warning time.Duration) error {
+	if warning < 0 {
+		return fmt.Errorf("invalid warning time: %v", warning)
+	}
+	return mc.simpleRequest(newWorldBorderCommand("warning").Literal("time").
+		Int(int(warning/time.Second)), "Set the world border warning time")
+}
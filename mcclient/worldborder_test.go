@@ -0,0 +1,92 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorldBorderGetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("worldborder get").
+		Return("The world border is currently 59999968 block(s) wide", nil)
+	width, err := tc.mc.WorldBorderGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if width != 59999968 {
+		t.Errorf("Expected: 59999968 Got: %v", width)
+	}
+}
+
+func TestWorldBorderSetSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("worldborder set 1000").
+		Return("Set the world border to 1000.0 block(s) wide", nil)
+	if err := tc.mc.WorldBorderSet(1000, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("worldborder set 100.5 600").
+		Return("Shrinking the world border to 100.5 block(s) wide over "+
+			"600 second(s)", nil)
+	if err := tc.mc.WorldBorderSet(100.5, 10*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("worldborder add 50 30").
+		Return("Growing the world border to 150.5 blocks wide over "+
+			"30 seconds", nil)
+	if err := tc.mc.WorldBorderAdd(50, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWorldBorderSetErrors(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("worldborder set 1000").
+		Return("Nothing changed. The world border is already that size", nil)
+	err := tc.mc.WorldBorderSet(1000, 0)
+	if !errors.Is(err, ErrNothingChanged) {
+		t.Errorf("Expected ErrNothingChanged: %v", err)
+	}
+	err = tc.mc.WorldBorderSet(0, 0)
+	expectError(t, err, "invalid width: 0")
+	err = tc.mc.WorldBorderAdd(10, -time.Second)
+	expectError(t, err, "invalid duration")
+}
+
+func TestWorldBorderSettingsSuccess(t *testing.T) {
+	tc := newTestContext(t)
+	defer tc.Finish()
+
+	tc.client.EXPECT().Request("worldborder center 0.5 -100").
+		Return("Set the center of the world border to 0.50, -100.00", nil)
+	if err := tc.mc.WorldBorderCenter(0.5, -100); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("worldborder damage amount 0.2").
+		Return("Set the world border damage to 0.20 per block each second", nil)
+	if err := tc.mc.WorldBorderDamage(0.2); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("worldborder damage buffer 5").
+		Return("Set the world border damage buffer to 5.00 block(s)", nil)
+	if err := tc.mc.WorldBorderDamageBuffer(5); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("worldborder warning distance 10").
+		Return("Set the world border warning distance to 10 block(s)", nil)
+	if err := tc.mc.WorldBorderWarningDistance(10); err != nil {
+		t.Fatal(err)
+	}
+	tc.client.EXPECT().Request("worldborder warning time 15").
+		Return("Set the world border warning time to 15 second(s)", nil)
+	if err := tc.mc.WorldBorderWarningTime(15 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
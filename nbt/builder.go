@@ -0,0 +1,64 @@
+package nbt
+
+// NewCompound returns an empty compound, ready for Set and the other
+// setters.
+func NewCompound() Compound {
+	return Compound{}
+}
+
+// Bool returns the byte the server uses to store b.
+func Bool(b bool) Byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Strings returns a list of strings, as used for Tags.
+func Strings(values ...string) List {
+	list := make(List, len(values))
+	for i, value := range values {
+		list[i] = String(value)
+	}
+	return list
+}
+
+// Doubles returns a list of doubles, as used for Pos and Motion.
+func Doubles(values ...float64) List {
+	list := make(List, len(values))
+	for i, value := range values {
+		list[i] = Double(value)
+	}
+	return list
+}
+
+// Floats returns a list of floats, as used for Rotation.
+func Floats(values ...float32) List {
+	list := make(List, len(values))
+	for i, value := range values {
+		list[i] = Float(value)
+	}
+	return list
+}
+
+// Set sets key to tag and returns the compound so that calls can be chained.
+// Like any map, a nil compound panics, so start from NewCompound.
+func (compound Compound) Set(key string, tag Tag) Compound {
+	compound[key] = tag
+	return compound
+}
+
+// SetBool sets key to a boolean byte.
+func (compound Compound) SetBool(key string, b bool) Compound {
+	return compound.Set(key, Bool(b))
+}
+
+// SetInt sets key to an int.
+func (compound Compound) SetInt(key string, i int32) Compound {
+	return compound.Set(key, Int(i))
+}
+
+// SetString sets key to a string.
+func (compound Compound) SetString(key, str string) Compound {
+	return compound.Set(key, String(str))
+}
@@ -0,0 +1,20 @@
+package nbt
+
+import "testing"
+
+func TestBuilder(t *testing.T) {
+	compound := NewCompound().
+		SetBool("NoAI", true).
+		SetBool("Silent", false).
+		SetInt("Age", -32768).
+		SetString("CustomName", `{"text":"Bob"}`).
+		Set("Tags", Strings("event", "spawned")).
+		Set("Motion", Doubles(0, 0.5, 0)).
+		Set("Rotation", Floats(90, 0))
+	expected := `{Age:-32768,CustomName:"{\"text\":\"Bob\"}",` +
+		`Motion:[0.0d,0.5d,0.0d],NoAI:1b,Rotation:[90.0f,0.0f],Silent:0b,` +
+		`Tags:["event","spawned"]}`
+	if str := compound.String(); str != expected {
+		t.Errorf("\nExpected: %s\nGot:      %s", expected, str)
+	}
+}
@@ -0,0 +1,324 @@
+package nbt
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	byteRegex   *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:0|[1-9][0-9]*)[bB]$`)
+	shortRegex  *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:0|[1-9][0-9]*)[sS]$`)
+	intRegex    *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:0|[1-9][0-9]*)$`)
+	longRegex   *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:0|[1-9][0-9]*)[lL]$`)
+	floatRegex  *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:[0-9]+\.?|[0-9]*\.[0-9]+)(?:[eE][-+]?[0-9]+)?[fF]$`)
+	doubleRegex *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:[0-9]+\.?|[0-9]*\.[0-9]+)(?:[eE][-+]?[0-9]+)?[dD]$`)
+	// Doubles may leave off the suffix as long as they are not integers.
+	bareDoubleRegex *regexp.Regexp = regexp.MustCompile(`^[-+]?(?:[0-9]+\.|[0-9]*\.[0-9]+|[0-9]+(?:\.[0-9]*)?[eE][-+]?[0-9]+)$`)
+)
+
+type parser struct {
+	input string
+	pos   int
+}
+
+// Parse parses a single SNBT value, such as the output of data get.
+func Parse(input string) (Tag, error) {
+	p := &parser{
+		input: input,
+	}
+	tag, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return nil, p.errorf("unexpected trailing data")
+	}
+	return tag, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid snbt at %d: %s", p.pos,
+		fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		return p.errorf("expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+func isUnquoted(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') || c == '_' || c == '-' || c == '.' || c == '+'
+}
+
+func (p *parser) parseUnquoted() string {
+	start := p.pos
+	for p.pos < len(p.input) && isUnquoted(p.input[p.pos]) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) parseQuoted() (string, error) {
+	quote := p.input[p.pos]
+	p.pos++
+	var out strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		switch {
+		case c == quote:
+			return out.String(), nil
+		case c != '\\':
+			out.WriteByte(c)
+		case p.pos >= len(p.input):
+			return "", p.errorf("unterminated escape")
+		default:
+			escaped := p.input[p.pos]
+			p.pos++
+			switch escaped {
+			case '\\', '"', '\'':
+				out.WriteByte(escaped)
+			case 'n':
+				out.WriteByte('\n')
+			case 't':
+				out.WriteByte('\t')
+			case 'r':
+				out.WriteByte('\r')
+			case 'b':
+				out.WriteByte('\b')
+			case 'f':
+				out.WriteByte('\f')
+			case 's':
+				out.WriteByte(' ')
+			case 'u':
+				if p.pos+4 > len(p.input) {
+					return "", p.errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(p.input[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					return "", p.errorf("invalid unicode escape")
+				}
+				p.pos += 4
+				var buf [utf8.UTFMax]byte
+				out.Write(buf[:utf8.EncodeRune(buf[:], rune(r))])
+			default:
+				return "", p.errorf("invalid escape '\\%c'", escaped)
+			}
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) parseKey() (string, error) {
+	p.skipSpace()
+	if c := p.peek(); c == '"' || c == '\'' {
+		return p.parseQuoted()
+	}
+	key := p.parseUnquoted()
+	if len(key) == 0 {
+		return "", p.errorf("expected key")
+	}
+	return key, nil
+}
+
+func (p *parser) parseValue() (Tag, error) {
+	p.skipSpace()
+	switch c := p.peek(); c {
+	case '{':
+		return p.parseCompound()
+	case '[':
+		return p.parseList()
+	case '"', '\'':
+		str, err := p.parseQuoted()
+		return String(str), err
+	case 0:
+		return nil, p.errorf("expected value")
+	}
+	start := p.pos
+	token := p.parseUnquoted()
+	if len(token) == 0 {
+		return nil, p.errorf("unexpected '%c'", p.peek())
+	}
+	tag, err := parseToken(token)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("%v", err)
+	}
+	return tag, nil
+}
+
+// parseToken converts an unquoted token into a number, boolean or string.
+func parseToken(token string) (Tag, error) {
+	trimmed := token[:len(token)-1]
+	switch {
+	case byteRegex.MatchString(token):
+		v, err := strconv.ParseInt(trimmed, 10, 8)
+		return Byte(v), err
+	case shortRegex.MatchString(token):
+		v, err := strconv.ParseInt(trimmed, 10, 16)
+		return Short(v), err
+	case longRegex.MatchString(token):
+		v, err := strconv.ParseInt(trimmed, 10, 64)
+		return Long(v), err
+	case intRegex.MatchString(token):
+		v, err := strconv.ParseInt(token, 10, 32)
+		return Int(v), err
+	case floatRegex.MatchString(token):
+		v, err := strconv.ParseFloat(trimmed, 32)
+		return Float(v), err
+	case doubleRegex.MatchString(token):
+		v, err := strconv.ParseFloat(trimmed, 64)
+		return Double(v), err
+	case bareDoubleRegex.MatchString(token):
+		v, err := strconv.ParseFloat(token, 64)
+		return Double(v), err
+	case token == "true":
+		return Byte(1), nil
+	case token == "false":
+		return Byte(0), nil
+	}
+	return String(token), nil
+}
+
+func (p *parser) parseCompound() (Tag, error) {
+	p.pos++
+	compound := Compound{}
+	p.skipSpace()
+	if p.peek() == '}' {
+		p.pos++
+		return compound, nil
+	}
+	for {
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		compound[key] = value
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return compound, nil
+		default:
+			return nil, p.errorf("expected ',' or '}'")
+		}
+	}
+}
+
+// parseElements parses a comma separated list of values ending in ']'.
+func (p *parser) parseElements() ([]Tag, error) {
+	elements := []Tag{}
+	p.skipSpace()
+	if p.peek() == ']' {
+		p.pos++
+		return elements, nil
+	}
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, value)
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return elements, nil
+		default:
+			return nil, p.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+func (p *parser) parseList() (Tag, error) {
+	p.pos++
+	p.skipSpace()
+	if p.pos+1 < len(p.input) && p.input[p.pos+1] == ';' {
+		return p.parseArray()
+	}
+	elements, err := p.parseElements()
+	if err != nil {
+		return nil, err
+	}
+	return List(elements), nil
+}
+
+func (p *parser) parseArray() (Tag, error) {
+	arrayType := p.input[p.pos]
+	p.pos += 2
+	start := p.pos
+	elements, err := p.parseElements()
+	if err != nil {
+		return nil, err
+	}
+	switch arrayType {
+	case 'B':
+		arr := ByteArray{}
+		for _, element := range elements {
+			b, ok := element.(Byte)
+			if !ok {
+				p.pos = start
+				return nil, p.errorf("expected byte in array: %v", element)
+			}
+			arr = append(arr, int8(b))
+		}
+		return arr, nil
+	case 'I':
+		arr := IntArray{}
+		for _, element := range elements {
+			i, ok := element.(Int)
+			if !ok {
+				p.pos = start
+				return nil, p.errorf("expected int in array: %v", element)
+			}
+			arr = append(arr, int32(i))
+		}
+		return arr, nil
+	case 'L':
+		arr := LongArray{}
+		for _, element := range elements {
+			l, ok := element.(Long)
+			if !ok {
+				p.pos = start
+				return nil, p.errorf("expected long in array: %v", element)
+			}
+			arr = append(arr, int64(l))
+		}
+		return arr, nil
+	}
+	p.pos = start - 2
+	return nil, p.errorf("invalid array type '%c'", arrayType)
+}
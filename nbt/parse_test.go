@@ -0,0 +1,100 @@
+package nbt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func expectError(t *testing.T, err error, contains string) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if !strings.Contains(err.Error(), contains) {
+		t.Errorf("Expected \"%s\": %v", contains, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected Tag
+	}
+	testCases := []testCase{
+		{"1b", Byte(1)},
+		{"-3s", Short(-3)},
+		{"42", Int(42)},
+		{"123456789012L", Long(123456789012)},
+		{"20.0f", Float(20)},
+		{"-1.5d", Double(-1.5)},
+		{"1.5", Double(1.5)},
+		{"1e3", Double(1000)},
+		{"true", Byte(1)},
+		{"false", Byte(0)},
+		{"minecraft", String("minecraft")},
+		{`"minecraft:stone"`, String("minecraft:stone")},
+		{`'It\'s "here"'`, String(`It's "here"`)},
+		{`"a\\b\ncé"`, String("a\\b\ncé")},
+		{"[B; 1B, -2b]", ByteArray{1, -2}},
+		{"[I; 1, 2, 3, 4]", IntArray{1, 2, 3, 4}},
+		{"[L;1L]", LongArray{1}},
+		{"[]", List{}},
+		{"[1.0d, 64.0d, -2.5d]", List{Double(1), Double(64), Double(-2.5)}},
+		{"{}", Compound{}},
+		{`{Pos: [1.0d, 64.0d, 2.0d], Health: 20.0f, "minecraft:custom_data": {a: 1b}}`,
+			Compound{
+				"Pos":    List{Double(1), Double(64), Double(2)},
+				"Health": Float(20),
+				"minecraft:custom_data": Compound{
+					"a": Byte(1),
+				},
+			}},
+		{` { Inventory : [ { Slot : 0b , id : "minecraft:stone" , count : 64 } ] } `,
+			Compound{
+				"Inventory": List{Compound{
+					"Slot":  Byte(0),
+					"id":    String("minecraft:stone"),
+					"count": Int(64),
+				}},
+			}},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.input, func(t *testing.T) {
+			tag, err := Parse(tcase.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tag, tcase.expected) {
+				t.Errorf("\nExpected: %#v\nGot:      %#v", tcase.expected, tag)
+			}
+		})
+	}
+}
+
+func TestParseFailures(t *testing.T) {
+	type testCase struct {
+		input string
+		err   string
+	}
+	testCases := []testCase{
+		{"", "expected value"},
+		{"{a:1", "expected ',' or '}'"},
+		{"{a 1}", "expected ':'"},
+		{"{:1}", "expected key"},
+		{"[1, 2", "expected ',' or ']'"},
+		{"[I; 1b]", "expected int in array"},
+		{"[X; 1]", "invalid array type"},
+		{`"abc`, "unterminated string"},
+		{`"\q"`, "invalid escape"},
+		{"300b", "out of range"},
+		{"1 2", "unexpected trailing data"},
+		{"@", "unexpected '@'"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.input, func(t *testing.T) {
+			_, err := Parse(tcase.input)
+			expectError(t, err, tcase.err)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package nbt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type pathNode struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// Path selects a tag within another, using the same syntax as the data
+// command, such as Inventory[0].id or Pos[-1].
+type Path struct {
+	nodes []pathNode
+}
+
+// ParsePath parses a path. Compound filters such as Inventory[{Slot:0b}] are
+// not supported.
+func ParsePath(path string) (Path, error) {
+	p := &parser{
+		input: path,
+	}
+	nodes := []pathNode{}
+	for p.pos < len(p.input) {
+		switch c := p.peek(); {
+		case c == '[':
+			p.pos++
+			end := strings.IndexByte(p.input[p.pos:], ']')
+			if end < 0 {
+				return Path{}, p.errorf("expected ']'")
+			}
+			index, err := strconv.Atoi(p.input[p.pos : p.pos+end])
+			if err != nil {
+				return Path{}, p.errorf("invalid index: %s",
+					p.input[p.pos:p.pos+end])
+			}
+			p.pos += end + 1
+			nodes = append(nodes, pathNode{index: index, isIndex: true})
+		case c == '.' && len(nodes) > 0:
+			p.pos++
+			fallthrough
+		default:
+			if c := p.peek(); c != '"' && c != '\'' && !isUnquoted(c) {
+				return Path{}, p.errorf("expected key")
+			}
+			key, err := p.parsePathKey()
+			if err != nil {
+				return Path{}, err
+			}
+			nodes = append(nodes, pathNode{key: key})
+		}
+	}
+	if len(nodes) == 0 {
+		return Path{}, fmt.Errorf("empty path")
+	}
+	return Path{nodes: nodes}, nil
+}
+
+// parsePathKey parses a key, which unlike compound keys can not contain dots.
+func (p *parser) parsePathKey() (string, error) {
+	if c := p.peek(); c == '"' || c == '\'' {
+		return p.parseQuoted()
+	}
+	start := p.pos
+	for p.pos < len(p.input) && isUnquoted(p.input[p.pos]) &&
+		p.input[p.pos] != '.' {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("expected key")
+	}
+	return p.input[start:p.pos], nil
+}
+
+// String formats the path in the syntax used by the data command.
+func (path Path) String() string {
+	var out strings.Builder
+	for i, node := range path.nodes {
+		if node.isIndex {
+			out.WriteString("[" + strconv.Itoa(node.index) + "]")
+			continue
+		}
+		if i > 0 {
+			out.WriteByte('.')
+		}
+		if unquotedKeyRegex.MatchString(node.key) &&
+			!strings.Contains(node.key, ".") {
+			out.WriteString(node.key)
+		} else {
+			out.WriteString(Quote(node.key))
+		}
+	}
+	return out.String()
+}
+
+func indexTag(tag Tag, index int) (Tag, error) {
+	var length int
+	var get func(i int) Tag
+	switch value := tag.(type) {
+	case List:
+		length, get = len(value), func(i int) Tag { return value[i] }
+	case ByteArray:
+		length, get = len(value), func(i int) Tag { return Byte(value[i]) }
+	case IntArray:
+		length, get = len(value), func(i int) Tag { return Int(value[i]) }
+	case LongArray:
+		length, get = len(value), func(i int) Tag { return Long(value[i]) }
+	default:
+		return nil, fmt.Errorf("can not index %T", tag)
+	}
+	if index < 0 {
+		index += length
+	}
+	if index < 0 || index >= length {
+		return nil, fmt.Errorf("index out of range: %d", index)
+	}
+	return get(index), nil
+}
+
+// Get returns the tag selected by the path.
+func (path Path) Get(tag Tag) (Tag, error) {
+	for i, node := range path.nodes {
+		var err error
+		if node.isIndex {
+			tag, err = indexTag(tag, node.index)
+		} else if compound, ok := tag.(Compound); !ok {
+			err = fmt.Errorf("can not get %s from %T", node.key, tag)
+		} else if tag, ok = compound[node.key]; !ok {
+			err = fmt.Errorf("missing %s", node.key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", Path{nodes: path.nodes[:i+1]}, err)
+		}
+	}
+	return tag, nil
+}
+
+// Get returns the tag at path within tag.
+func Get(tag Tag, path string) (Tag, error) {
+	parsed, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Get(tag)
+}
@@ -0,0 +1,75 @@
+package nbt
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	testPlayer Tag = Compound{
+		"Pos": List{Double(1), Double(64), Double(2)},
+		"Inventory": List{
+			Compound{"Slot": Byte(0), "id": String("minecraft:stone")},
+			Compound{"Slot": Byte(1), "id": String("minecraft:dirt")},
+		},
+		"UUID":        IntArray{1, 2, 3, 4},
+		"minecraft:x": Compound{"a.b": Int(5)},
+	}
+)
+
+func TestPathGet(t *testing.T) {
+	type testCase struct {
+		path     string
+		expected Tag
+	}
+	testCases := []testCase{
+		{"Pos", List{Double(1), Double(64), Double(2)}},
+		{"Pos[1]", Double(64)},
+		{"Pos[-1]", Double(2)},
+		{"Inventory[1].id", String("minecraft:dirt")},
+		{"UUID[3]", Int(4)},
+		{`"minecraft:x"."a.b"`, Int(5)},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.path, func(t *testing.T) {
+			tag, err := Get(testPlayer, tcase.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tag, tcase.expected) {
+				t.Errorf("\nExpected: %#v\nGot:      %#v", tcase.expected, tag)
+			}
+			path, err := ParsePath(tcase.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if path.String() != tcase.path {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.path, path)
+			}
+		})
+	}
+}
+
+func TestPathGetFailures(t *testing.T) {
+	type testCase struct {
+		path string
+		err  string
+	}
+	testCases := []testCase{
+		{"", "empty path"},
+		{".Pos", "expected key"},
+		{"Pos[", "expected ']'"},
+		{"Pos[a]", "invalid index: a"},
+		{"Pos[3]", "Pos[3]: index out of range: 3"},
+		{"Missing", "Missing: missing Missing"},
+		{"Pos.x", "Pos.x: can not get x from nbt.List"},
+		{"Inventory[0].id[0]", "can not index nbt.String"},
+		{"Inventory[{Slot:0b}]", "invalid index"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.path, func(t *testing.T) {
+			_, err := Get(testPlayer, tcase.path)
+			expectError(t, err, tcase.err)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Package nbt parses and formats Minecraft's stringified NBT (SNBT), the
+// format the server uses to print entity, block and storage data.
+package nbt
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	unquotedKeyRegex *regexp.Regexp = regexp.MustCompile(`^[\w\-\.\+]+$`)
+)
+
+// Tag is a single NBT value. String returns the value formatted as SNBT.
+type Tag interface {
+	String() string
+}
+
+// Byte is an 8-bit integer, also used for booleans.
+type Byte int8
+
+// Short is a 16-bit integer.
+type Short int16
+
+// Int is a 32-bit integer.
+type Int int32
+
+// Long is a 64-bit integer.
+type Long int64
+
+// Float is a 32-bit floating point number.
+type Float float32
+
+// Double is a 64-bit floating point number.
+type Double float64
+
+// String is a string.
+type String string
+
+// ByteArray is an array of bytes.
+type ByteArray []int8
+
+// IntArray is an array of ints.
+type IntArray []int32
+
+// LongArray is an array of longs.
+type LongArray []int64
+
+// List is a list of tags.
+type List []Tag
+
+// Compound maps names to tags.
+type Compound map[string]Tag
+
+// String formats the byte as SNBT.
+func (b Byte) String() string {
+	return strconv.FormatInt(int64(b), 10) + "b"
+}
+
+// String formats the short as SNBT.
+func (s Short) String() string {
+	return strconv.FormatInt(int64(s), 10) + "s"
+}
+
+// String formats the int as SNBT.
+func (i Int) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+// String formats the long as SNBT.
+func (l Long) String() string {
+	return strconv.FormatInt(int64(l), 10) + "L"
+}
+
+func formatFloat(f float64, bits int) string {
+	str := strconv.FormatFloat(f, 'f', -1, bits)
+	if !strings.ContainsAny(str, ".IN") {
+		str += ".0"
+	}
+	return str
+}
+
+// String formats the float as SNBT.
+func (f Float) String() string {
+	return formatFloat(float64(f), 32) + "f"
+}
+
+// String formats the double as SNBT.
+func (d Double) String() string {
+	return formatFloat(float64(d), 64) + "d"
+}
+
+// Quote quotes str as an SNBT string.
+func Quote(str string) string {
+	str = strings.ReplaceAll(str, `\`, `\\`)
+	str = strings.ReplaceAll(str, `"`, `\"`)
+	return `"` + str + `"`
+}
+
+// String formats the string as quoted SNBT.
+func (s String) String() string {
+	return Quote(string(s))
+}
+
+func formatArray(prefix string, length int,
+	format func(i int) string) string {
+	parts := make([]string, length)
+	for i := range parts {
+		parts[i] = format(i)
+	}
+	return "[" + prefix + strings.Join(parts, ",") + "]"
+}
+
+// String formats the array as SNBT.
+func (arr ByteArray) String() string {
+	return formatArray("B;", len(arr), func(i int) string {
+		return Byte(arr[i]).String()
+	})
+}
+
+// String formats the array as SNBT.
+func (arr IntArray) String() string {
+	return formatArray("I;", len(arr), func(i int) string {
+		return Int(arr[i]).String()
+	})
+}
+
+// String formats the array as SNBT.
+func (arr LongArray) String() string {
+	return formatArray("L;", len(arr), func(i int) string {
+		return Long(arr[i]).String()
+	})
+}
+
+// String formats the list as SNBT.
+func (list List) String() string {
+	return formatArray("", len(list), func(i int) string {
+		return list[i].String()
+	})
+}
+
+func formatKey(key string) string {
+	if unquotedKeyRegex.MatchString(key) {
+		return key
+	}
+	return Quote(key)
+}
+
+// Keys returns the names in the compound in sorted order.
+func (compound Compound) Keys() []string {
+	keys := make([]string, 0, len(compound))
+	for key := range compound {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// String formats the compound as SNBT, with keys in sorted order.
+func (compound Compound) String() string {
+	keys := compound.Keys()
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = formatKey(key) + ":" + compound[key].String()
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// Number returns the value of a numeric tag as a float64.
+func Number(tag Tag) (float64, bool) {
+	switch value := tag.(type) {
+	case Byte:
+		return float64(value), true
+	case Short:
+		return float64(value), true
+	case Int:
+		return float64(value), true
+	case Long:
+		return float64(value), true
+	case Float:
+		return float64(value), true
+	case Double:
+		return float64(value), true
+	}
+	return 0, false
+}
@@ -0,0 +1,56 @@
+package nbt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagString(t *testing.T) {
+	type testCase struct {
+		tag      Tag
+		expected string
+	}
+	testCases := []testCase{
+		{Byte(1), "1b"},
+		{Short(-2), "-2s"},
+		{Int(3), "3"},
+		{Long(4), "4L"},
+		{Float(20), "20.0f"},
+		{Float(0.5), "0.5f"},
+		{Double(-1.25), "-1.25d"},
+		{String(`say "hi"`), `"say \"hi\""`},
+		{ByteArray{1, 2}, "[B;1b,2b]"},
+		{IntArray{1, 2}, "[I;1,2]"},
+		{LongArray{1, 2}, "[L;1L,2L]"},
+		{List{Int(1), String("a")}, `[1,"a"]`},
+		{Compound{"b": Byte(1), "a": Compound{}, "minecraft:x": Int(1)},
+			`{a:{},b:1b,"minecraft:x":1}`},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.expected, func(t *testing.T) {
+			if str := tcase.tag.String(); str != tcase.expected {
+				t.Errorf("\nExpected: %s\nGot:      %s", tcase.expected, str)
+			}
+			parsed, err := Parse(tcase.tag.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(parsed, tcase.tag) {
+				t.Errorf("\nExpected: %#v\nGot:      %#v", tcase.tag, parsed)
+			}
+		})
+	}
+}
+
+func TestNumber(t *testing.T) {
+	for _, tag := range []Tag{Byte(2), Short(2), Int(2), Long(2), Float(2),
+		Double(2)} {
+		num, ok := Number(tag)
+		if !ok || num != 2 {
+			t.Errorf("Expected 2 from %#v, got: %v %v", tag, num, ok)
+		}
+	}
+	if _, ok := Number(String("2")); ok {
+		t.Error("Expected strings to not be numbers")
+	}
+}